@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// lintDirectivePrefixes are the prefixes of comment lines that instruct linters rather than document the API.
+var lintDirectivePrefixes = []string{"buf:lint:", "protolint:", "nolint"}
+
+// description returns the documentation text of the comments attached to a proto element. The leading comment is always used; leading-detached and trailing comments are only included with the comments=all option.
+func (g *generator) description(comments protogen.CommentSet) string {
+	var parts []string
+	if g.conf.Comments == "all" {
+		for _, c := range comments.LeadingDetached {
+			parts = append(parts, cleanComment(c))
+		}
+	}
+	parts = append(parts, cleanComment(comments.Leading))
+	if g.conf.Comments == "all" {
+		parts = append(parts, cleanComment(comments.Trailing))
+	}
+	var text []string
+	for _, p := range parts {
+		if p != "" {
+			text = append(text, p)
+		}
+	}
+	return strings.Join(text, "\n\n")
+}
+
+// cleanComment returns the text of a comment without the space following the comment markers, lint directives and surrounding blank lines.
+func cleanComment(c protogen.Comments) string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSuffix(string(c), "\n"), "\n") {
+		line = strings.TrimRight(strings.TrimPrefix(line, " "), " \t")
+		if isLintDirective(line) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+func isLintDirective(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, prefix := range lintDirectivePrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// summarize splits a description into its first paragraph, with its lines joined into one, and the remaining paragraphs.
+func summarize(description string) (string, string) {
+	summary, rest, _ := strings.Cut(description, "\n\n")
+	return strings.Join(strings.Fields(summary), " "), strings.TrimLeft(rest, "\n")
+}
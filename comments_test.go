@@ -0,0 +1,80 @@
+package main
+
+import "testing"
+
+const commentedProto = `
+	syntax = "proto3";
+	package example.v1;
+	import "google/api/annotations.proto";
+
+	// Detached comment about the service.
+
+	// Manages books.
+	// buf:lint:ignore SERVICE_SUFFIX
+	service Library {
+		// Returns a book.
+		//
+		// Fails with NOT_FOUND when the book
+		// does not exist.
+		rpc GetBook(GetBookRequest) returns (Book) {
+			option (google.api.http) = {get: "/v1/{name=books/*}"};
+		}
+	}
+	// A book on a shelf.
+	message Book {
+		// The resource name of the book.
+		string name = 1; // Immutable.
+		// The state of the book.
+		State state = 2;
+	}
+	// Availability of a book.
+	enum State {
+		// The state is not known.
+		STATE_UNSPECIFIED = 0;
+		STATE_LENT = 1; // Lent to a reader.
+	}
+	message GetBookRequest {
+		string name = 1;
+	}
+`
+
+func TestDescriptions(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": commentedProto}, "")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	assertPath(t, schemas, "A book on a shelf.", "example.v1.Book", "description")
+	assertPath(t, schemas, "The resource name of the book.", "example.v1.Book", "properties", "name", "description")
+	assertPath(t, schemas, "The state of the book.", "example.v1.Book", "properties", "state", "description")
+	assertPath(t, schemas, "#/components/schemas/example.v1.State", "example.v1.Book", "properties", "state", "$ref")
+	assertPath(t, schemas, "Availability of a book.", "example.v1.State", "description")
+	assertPath(t, schemas, []interface{}{"The state is not known.", ""}, "example.v1.State", "x-enum-descriptions")
+	assertNoPath(t, schemas, "example.v1.GetBookRequest", "description")
+
+	get := mustLookup(t, doc, "paths", "/v1/books/{book}", "get")
+	assertPath(t, get, "Returns a book.", "summary")
+	assertPath(t, get, "Fails with NOT_FOUND when the book\ndoes not exist.", "description")
+	assertPath(t, doc, "example.v1.Library", "tags", 0, "name")
+	assertPath(t, doc, "Manages books.", "tags", 0, "description")
+
+	doc = generateYAML(t, map[string]string{"library.proto": commentedProto}, "comments=all")
+	schemas = mustLookup(t, doc, "components", "schemas")
+	assertPath(t, schemas, "The resource name of the book.\n\nImmutable.", "example.v1.Book", "properties", "name", "description")
+	assertPath(t, schemas, []interface{}{"The state is not known.", "Lent to a reader."}, "example.v1.State", "x-enum-descriptions")
+	assertPath(t, doc, "Detached comment about the service.\n\nManages books.", "tags", 0, "description")
+}
+
+func TestSummarize(t *testing.T) {
+	for _, tt := range []struct {
+		description string
+		summary     string
+		rest        string
+	}{
+		{"", "", ""},
+		{"Returns a book.", "Returns a book.", ""},
+		{"Returns a book\nby name.\n\nDetails.\n\nMore.", "Returns a book by name.", "Details.\n\nMore."},
+	} {
+		summary, rest := summarize(tt.description)
+		if summary != tt.summary || rest != tt.rest {
+			t.Errorf("summarize(%q) = %q, %q, want %q, %q", tt.description, summary, rest, tt.summary, tt.rest)
+		}
+	}
+}
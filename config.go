@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the options of the plugin, passed to protoc as --openapi_opt=name=value.
+type Config struct {
+	// Title is the title of the API in the info object of the document.
+	Title string
+	// Version is the version of the API in the info object of the document.
+	Version string
+	// ConfigFile is the path of a YAML file, relative to the working directory of protoc, holding the info, servers and externalDocs of the documents.
+	ConfigFile string
+	// OutputFormat selects the files written for a document: "yaml", "json" or "both".
+	OutputFormat string
+	// OutputMode selects how the files of the request are split into documents: "merged" writes a single document, "package" one document per proto package and "file" one document per proto file.
+	OutputMode string
+	// EnumType selects how enum values are represented: "string" for value names, "integer" for value numbers, or "both" to accept either, for gateways configured with protojson UseEnumNumbers.
+	EnumType string
+	// OneofStyle selects how oneof groups are described: "exclusive" adds oneOf constraints allowing at most one member of each group, "annotated" only lists the groups in an x-oneof extension.
+	OneofStyle string
+	// Comments selects the proto comments used as descriptions: "leading" for the comment directly above an element, or "all" to also include the detached comments above it and the comment following it.
+	Comments string
+	// Naming selects the names of component schemas and services, which name tags and prefix operation IDs: "fqn" for fully-qualified names such as example.v1.SearchRequest, "short" for the bare name, "package_prefixed" for the bare name prefixed with the last package component such as V1SearchRequest, or "nested" for the names of enclosing messages joined with underscores such as Outer_Inner. Colliding names fall back to the following strategies in that order. Dots in operation IDs are replaced by underscores.
+	Naming string
+	// UseProtoNames names properties after the proto field names instead of their JSON names, for gateways using protojson UseProtoNames.
+	UseProtoNames bool
+	// EmptyNoContent describes the responses of methods returning google.protobuf.Empty as 204 No Content instead of 200 OK with an empty object, for gateways that do not write a body for them.
+	EmptyNoContent bool
+	// ErrorResponses adds responses for the HTTP status codes of the common gRPC error codes to every operation, next to the default error response.
+	ErrorResponses bool
+	// OmitEnumUnspecified drops the zero value of enums from requests when it is named UNSPECIFIED or ends with _UNSPECIFIED, so that clients cannot send it. Request bodies use variants of the schemas reaching such enums, named with an Input suffix, while responses keep the value.
+	OmitEnumUnspecified bool
+	// Int64Type selects how 64-bit integers are represented: "string" as protojson writes them, or "integer" for gateways that write them as numbers.
+	Int64Type string
+
+	// document holds the document-level values read from the config file.
+	document documentConfig
+}
+
+// documentConfig is the content of the config file: the objects of an OpenAPI document that cannot be derived from proto files.
+type documentConfig struct {
+	Info       Info     `yaml:"info"`
+	Servers    []Server `yaml:"servers"`
+	Components struct {
+		SecuritySchemes map[string]SecurityScheme `yaml:"securitySchemes"`
+	} `yaml:"components"`
+	Security     []SecurityRequirement  `yaml:"security"`
+	ExternalDocs *ExternalDocumentation `yaml:"externalDocs"`
+}
+
+func newConfig() *Config {
+	return &Config{OutputFormat: "yaml", OutputMode: "merged", EnumType: "string", Int64Type: "string", OneofStyle: "exclusive", Comments: "leading", Naming: "fqn"}
+}
+
+// flags returns a flag set through which the plugin options update the config.
+func (c *Config) flags() *flag.FlagSet {
+	flags := flag.NewFlagSet("protoc-gen-openapi", flag.ContinueOnError)
+	flags.StringVar(&c.Title, "title", c.Title, "title of the API")
+	flags.StringVar(&c.Version, "version", c.Version, "version of the API")
+	flags.StringVar(&c.ConfigFile, "openapi_config", c.ConfigFile, "YAML file with the info, servers and externalDocs of the documents")
+	flags.Var(&choiceValue{&c.OutputFormat, []string{"yaml", "json", "both"}}, "output_format", "format of the generated documents")
+	flags.Var(&choiceValue{&c.OutputMode, []string{"merged", "package", "file"}}, "output_mode", "layout of the generated documents")
+	flags.Var(&choiceValue{&c.EnumType, []string{"string", "integer", "both"}}, "enum_type", "representation of enum values")
+	flags.Var(&choiceValue{&c.OneofStyle, []string{"exclusive", "annotated"}}, "oneof_style", "description of oneof groups")
+	flags.Var(&choiceValue{&c.Comments, []string{"leading", "all"}}, "comments", "proto comments used as descriptions")
+	flags.Var(&choiceValue{&c.Naming, []string{"fqn", "short", "package_prefixed", "nested"}}, "naming", "naming strategy of schemas and operation IDs")
+	flags.BoolVar(&c.UseProtoNames, "use_proto_names", c.UseProtoNames, "name properties after proto field names")
+	flags.BoolVar(&c.EmptyNoContent, "empty_no_content", c.EmptyNoContent, "describe google.protobuf.Empty responses as 204 No Content")
+	flags.BoolVar(&c.ErrorResponses, "error_responses", c.ErrorResponses, "add responses for common gRPC error codes")
+	flags.BoolVar(&c.OmitEnumUnspecified, "omit_enum_unspecified", c.OmitEnumUnspecified, "drop UNSPECIFIED zero values of enums from requests")
+	flags.Var(&choiceValue{&c.Int64Type, []string{"string", "integer"}}, "int64_type", "representation of 64-bit integers")
+	return flags
+}
+
+// load reads the config file, if any. The title and version options take precedence over the info of the file.
+func (c *Config) load() error {
+	if c.ConfigFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.ConfigFile)
+	if err != nil {
+		return fmt.Errorf("openapi_config: %v", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&c.document); err != nil && err != io.EOF {
+		return fmt.Errorf("openapi_config: %s: %v", c.ConfigFile, err)
+	}
+	if err := c.document.validate(); err != nil {
+		return fmt.Errorf("openapi_config: %s: %v", c.ConfigFile, err)
+	}
+	// The scopes of OAuth flows are required, so flows without them are written with none rather than null.
+	for _, s := range c.document.Components.SecuritySchemes {
+		if s.Flows == nil {
+			continue
+		}
+		for _, f := range []*OAuthFlow{s.Flows.Implicit, s.Flows.Password, s.Flows.ClientCredentials, s.Flows.AuthorizationCode} {
+			if f != nil && f.Scopes == nil {
+				f.Scopes = map[string]string{}
+			}
+		}
+	}
+	return nil
+}
+
+// validate checks the fields that the OpenAPI specification requires of the objects of the file.
+func (d *documentConfig) validate() error {
+	if l := d.Info.License; l != nil {
+		if l.Name == "" {
+			return fmt.Errorf("info.license.name is required")
+		}
+		if l.Identifier != "" && l.URL != "" {
+			return fmt.Errorf("info.license.identifier and info.license.url are mutually exclusive")
+		}
+	}
+	for i, s := range d.Servers {
+		if s.URL == "" {
+			return fmt.Errorf("servers[%d].url is required", i)
+		}
+		for name, v := range s.Variables {
+			if v.Default == "" {
+				return fmt.Errorf("servers[%d].variables.%s.default is required", i, name)
+			}
+		}
+	}
+	if d.ExternalDocs != nil && d.ExternalDocs.URL == "" {
+		return fmt.Errorf("externalDocs.url is required")
+	}
+	for _, name := range sortedMap(d.Components.SecuritySchemes).Keys() {
+		if err := validateSecurityScheme(d.Components.SecuritySchemes[name]); err != nil {
+			return fmt.Errorf("components.securitySchemes.%s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// validateSecurityScheme checks the fields that the OpenAPI specification requires of each type of security scheme.
+func validateSecurityScheme(s SecurityScheme) error {
+	switch s.Type {
+	case "apiKey":
+		if s.Name == "" || (s.In != "query" && s.In != "header" && s.In != "cookie") {
+			return fmt.Errorf("apiKey requires a name and in: query, header or cookie")
+		}
+	case "http":
+		if s.Scheme == "" {
+			return fmt.Errorf("http requires a scheme")
+		}
+	case "oauth2":
+		return validateOAuthFlows(s.Flows)
+	case "openIdConnect":
+		if s.OpenIDConnectURL == "" {
+			return fmt.Errorf("openIdConnect requires an openIdConnectUrl")
+		}
+	case "mutualTLS":
+	default:
+		return fmt.Errorf("type must be one of apiKey, http, mutualTLS, oauth2, openIdConnect")
+	}
+	return nil
+}
+
+// validateOAuthFlows checks that an oauth2 scheme has at least one flow, and the URLs that each type of flow requires.
+func validateOAuthFlows(f *OAuthFlows) error {
+	if f == nil || (f.Implicit == nil && f.Password == nil && f.ClientCredentials == nil && f.AuthorizationCode == nil) {
+		return fmt.Errorf("oauth2 requires at least one flow")
+	}
+	if f.Implicit != nil && f.Implicit.AuthorizationURL == "" {
+		return fmt.Errorf("flows.implicit requires an authorizationUrl")
+	}
+	if f.Password != nil && f.Password.TokenURL == "" {
+		return fmt.Errorf("flows.password requires a tokenUrl")
+	}
+	if f.ClientCredentials != nil && f.ClientCredentials.TokenURL == "" {
+		return fmt.Errorf("flows.clientCredentials requires a tokenUrl")
+	}
+	if f.AuthorizationCode != nil && (f.AuthorizationCode.AuthorizationURL == "" || f.AuthorizationCode.TokenURL == "") {
+		return fmt.Errorf("flows.authorizationCode requires an authorizationUrl and a tokenUrl")
+	}
+	return nil
+}
+
+// formats returns the file extensions of the documents to write for the output format.
+func (c *Config) formats() []string {
+	if c.OutputFormat == "both" {
+		return []string{"yaml", "json"}
+	}
+	return []string{c.OutputFormat}
+}
+
+// paramFunc returns a function suitable for protogen.Options.ParamFunc that sets the plugin options on the config.
+func (c *Config) paramFunc() func(name, value string) error {
+	flags := c.flags()
+	return func(name, value string) error {
+		f := flags.Lookup(name)
+		if f == nil {
+			return fmt.Errorf("unknown option %q", name)
+		}
+		// A boolean option given without a value, such as --openapi_opt=omit_enum_unspecified, is enabled.
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() && value == "" {
+			value = "true"
+		}
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("invalid value %q for option %q: %v", value, name, err)
+		}
+		return nil
+	}
+}
+
+// choiceValue is a flag.Value that only accepts one of a fixed set of strings.
+type choiceValue struct {
+	value   *string
+	choices []string
+}
+
+func (v *choiceValue) String() string {
+	if v.value == nil {
+		return ""
+	}
+	return *v.value
+}
+
+func (v *choiceValue) Set(s string) error {
+	for _, c := range v.choices {
+		if s == c {
+			*v.value = s
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %s", strings.Join(v.choices, ", "))
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const searchProto = `
+	syntax = "proto3";
+	package example;
+	message SearchRequest {
+		string query = 1;
+		Corpus corpus = 2;
+	}
+	enum Corpus {
+		UNIVERSAL = 0;
+		WEB = 1;
+	}
+`
+
+func TestConfigOptions(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"search.proto": searchProto}, "title=Search API,version=v1.2.0,enum_type=integer")
+	assertPath(t, doc, "Search API", "info", "title")
+	assertPath(t, doc, "v1.2.0", "info", "version")
+	assertPath(t, doc, "integer", "components", "schemas", "example.Corpus", "type")
+}
+
+func TestConfigDefaults(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"search.proto": searchProto}, "")
+	assertPath(t, doc, "#/components/schemas/example.Corpus", "components", "schemas", "example.SearchRequest", "properties", "corpus", "$ref")
+	assertPath(t, doc, "string", "components", "schemas", "example.Corpus", "type")
+}
+
+func TestConfigErrors(t *testing.T) {
+	for _, tt := range []struct {
+		parameter string
+		want      string
+	}{
+		{"colour=blue", `unknown option "colour"`},
+		{"enum_type=float", `invalid value "float" for option "enum_type": must be one of string, integer, both`},
+	} {
+		req := newRequest(t, map[string]string{"search.proto": searchProto}, tt.parameter)
+		_, err := protogen.Options{ParamFunc: newConfig().paramFunc()}.New(req)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("%s: got error %v, want %s", tt.parameter, err, tt.want)
+		}
+	}
+}
+
+const configFile = `
+info:
+  title: Search API
+  description: Searches the web.
+  contact:
+    name: Search team
+    email: search@example.com
+  license:
+    name: Apache 2.0
+    identifier: Apache-2.0
+servers:
+  - url: https://{region}.example.com/v1
+    variables:
+      region:
+        default: eu
+        enum: [eu, us]
+components:
+  securitySchemes:
+    bearer:
+      type: http
+      scheme: bearer
+      bearerFormat: JWT
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://example.com/token
+security:
+  - bearer: []
+externalDocs:
+  url: https://example.com/docs
+`
+
+func TestConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "openapi.yaml"), []byte(configFile), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// The file is read relative to the working directory of protoc, which the plugin inherits.
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	doc := generateYAML(t, map[string]string{"search.proto": searchProto}, "openapi_config=openapi.yaml,version=v2")
+	assertPath(t, doc, "Search API", "info", "title")
+	assertPath(t, doc, "v2", "info", "version")
+	assertPath(t, doc, "Searches the web.", "info", "description")
+	assertPath(t, doc, "search@example.com", "info", "contact", "email")
+	assertPath(t, doc, "Apache-2.0", "info", "license", "identifier")
+	assertPath(t, doc, "https://{region}.example.com/v1", "servers", 0, "url")
+	assertPath(t, doc, []interface{}{"eu", "us"}, "servers", 0, "variables", "region", "enum")
+	assertPath(t, doc, "https://example.com/docs", "externalDocs", "url")
+	assertPath(t, doc, "JWT", "components", "securitySchemes", "bearer", "bearerFormat")
+	assertPath(t, doc, []interface{}{}, "security", 0, "bearer")
+	assertPath(t, doc, map[string]interface{}{}, "components", "securitySchemes", "oauth", "flows", "clientCredentials", "scopes")
+
+	out := runPlugin(t, newRequest(t, map[string]string{"search.proto": searchProto}, "openapi_config=openapi.yaml,output_format=json"))
+	if !strings.Contains(out["openapi.json"], `"scopes": {}`) {
+		t.Errorf("flow without scopes is not written with empty scopes:\n%s", out["openapi.json"])
+	}
+}
+
+func TestConfigFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	for _, tt := range []struct {
+		content string
+		want    string
+	}{
+		{"info:\n  titel: Search API\n", "field titel not found"},
+		{"info:\n  license:\n    identifier: MIT\n", "info.license.name is required"},
+		{"servers:\n  - description: Production\n", "servers[0].url is required"},
+		{"servers:\n  - url: https://{region}.example.com\n    variables:\n      region: {}\n", "servers[0].variables.region.default is required"},
+		{"components:\n  securitySchemes:\n    key:\n      type: apiKey\n      name: key\n", "components.securitySchemes.key: apiKey requires a name and in"},
+		{"components:\n  securitySchemes:\n    basic:\n      type: basic\n", "components.securitySchemes.basic: type must be one of"},
+		{"components:\n  securitySchemes:\n    oauth:\n      type: oauth2\n      flows: {}\n", "components.securitySchemes.oauth: oauth2 requires at least one flow"},
+		{"components:\n  securitySchemes:\n    oauth:\n      type: oauth2\n      flows:\n        implicit:\n          tokenUrl: https://example.com/token\n", "flows.implicit requires an authorizationUrl"},
+		{"components:\n  securitySchemes:\n    oauth:\n      type: oauth2\n      flows:\n        password: {}\n", "flows.password requires a tokenUrl"},
+		{"components:\n  securitySchemes:\n    oauth:\n      type: oauth2\n      flows:\n        clientCredentials:\n          authorizationUrl: https://example.com/auth\n", "flows.clientCredentials requires a tokenUrl"},
+		{"components:\n  securitySchemes:\n    oauth:\n      type: oauth2\n      flows:\n        authorizationCode:\n          authorizationUrl: https://example.com/auth\n", "flows.authorizationCode requires an authorizationUrl and a tokenUrl"},
+	} {
+		name := filepath.Join(dir, "openapi.yaml")
+		if err := os.WriteFile(name, []byte(tt.content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		conf := newConfig()
+		conf.ConfigFile = name
+		if err := conf.load(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%q: got error %v, want %s", tt.content, err, tt.want)
+		}
+	}
+	conf := newConfig()
+	conf.ConfigFile = filepath.Join(dir, "missing.yaml")
+	if err := conf.load(); err == nil {
+		t.Error("got no error for a missing file")
+	}
+}
+
+func TestDefaultInfo(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": libraryProto}, "")
+	assertPath(t, doc, "Library", "info", "title")
+	assertPath(t, doc, "v1", "info", "version")
+
+	doc = generateYAML(t, map[string]string{"search.proto": searchProto}, "")
+	assertPath(t, doc, "example", "info", "title")
+	assertPath(t, doc, "0.0.0", "info", "version")
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// addEnum registers the enum as a component schema of the document, unless it already is.
+func (g *generator) addEnum(e *protogen.Enum) {
+	name := g.names.schema(e.Desc)
+	if _, ok := g.schemas[name]; ok {
+		return
+	}
+	g.schemas[name] = g.enumSchema(e, false)
+}
+
+// enumSchema returns the schema listing the values of the enum, by name, number or both depending on the enum type option. The comments of the values are listed in x-enum-descriptions, in the order of the enum list, and the names of deprecated values in x-enum-deprecated. The schema of requests, selected by input, leaves out the value omitted by the omit_enum_unspecified option.
+func (g *generator) enumSchema(e *protogen.Enum, input bool) *Schema {
+	var names, numbers []interface{}
+	var nameDescriptions, numberDescriptions []string
+	var deprecated []string
+	hasDescriptions := false
+	seen := map[int32]bool{}
+	var omitted *protogen.EnumValue
+	if input {
+		omitted = g.omittedValue(e)
+	}
+	for _, v := range e.Values {
+		number := int32(v.Desc.Number())
+		if v == omitted {
+			continue
+		}
+		description := g.description(v.Comments)
+		hasDescriptions = hasDescriptions || description != ""
+		names = append(names, string(v.Desc.Name()))
+		nameDescriptions = append(nameDescriptions, description)
+		// A number shared by aliases is listed once, described by its first value, which protojson writes.
+		if !seen[number] {
+			seen[number] = true
+			numbers = append(numbers, number)
+			numberDescriptions = append(numberDescriptions, description)
+		}
+		if v.Desc.Options().(*descriptorpb.EnumValueOptions).GetDeprecated() {
+			deprecated = append(deprecated, string(v.Desc.Name()))
+		}
+	}
+
+	var s *Schema
+	var descriptions []string
+	switch g.conf.EnumType {
+	case "integer":
+		s = &Schema{Type: SchemaType{"integer"}, Format: "int32", Enum: numbers}
+		descriptions = numberDescriptions
+	case "both":
+		s = &Schema{Type: SchemaType{"string", "integer"}, Enum: append(names, numbers...)}
+		descriptions = append(nameDescriptions, numberDescriptions...)
+	default:
+		s = &Schema{Type: SchemaType{"string"}, Enum: names}
+		descriptions = nameDescriptions
+	}
+	s.Description = g.description(e.Comments)
+	s.Deprecated = e.Desc.Options().(*descriptorpb.EnumOptions).GetDeprecated()
+	if hasDescriptions {
+		s.Extensions.Set("x-enum-descriptions", descriptions)
+	}
+	if len(deprecated) > 0 {
+		s.Extensions.Set("x-enum-deprecated", deprecated)
+	}
+	return s
+}
+
+// omittedValue returns the zero value of the enum that the omit_enum_unspecified option leaves out of requests, or nil. Responses keep it, as protojson writes it in repeated and map fields, and in all fields with EmitUnpopulated as grpc-gateway does by default. The value is kept when it is the only one of the enum, as an empty enum list would accept any value.
+func (g *generator) omittedValue(e *protogen.Enum) *protogen.EnumValue {
+	if !g.conf.OmitEnumUnspecified {
+		return nil
+	}
+	for _, v := range e.Values {
+		if v.Desc.Number() != 0 || !isUnspecified(string(v.Desc.Name())) {
+			continue
+		}
+		if len(e.Values) == 1 {
+			g.warnings = append(g.warnings, fmt.Sprintf("%s: %s is the only value of the enum, keeping it despite omit_enum_unspecified", e.Desc.FullName(), v.Desc.Name()))
+			return nil
+		}
+		return v
+	}
+	return nil
+}
+
+// isUnspecified reports whether the enum value name follows the convention for a zero value meaning that no value was set.
+func isUnspecified(name string) bool {
+	return name == "UNSPECIFIED" || strings.HasSuffix(name, "_UNSPECIFIED")
+}
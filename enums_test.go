@@ -0,0 +1,123 @@
+package main
+
+import "testing"
+
+const enumProto = `
+	syntax = "proto3";
+	package example;
+	message Task {
+		enum State {
+			option allow_alias = true;
+			STATE_UNSPECIFIED = 0;
+			// Waiting to be picked up.
+			STATE_PENDING = 1;
+			STATE_RUNNING = 2;
+			STATE_STARTED = 2 [deprecated = true];
+		}
+		State state = 1;
+		Priority priority = 2;
+	}
+	enum Priority {
+		option deprecated = true;
+		LOW = 0;
+		HIGH = 1;
+	}
+	enum Unused {
+		UNUSED_UNSPECIFIED = 0;
+	}
+`
+
+func TestEnums(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"enums.proto": enumProto}, "")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	assertPath(t, schemas, "#/components/schemas/example.Task.State", "example.Task", "properties", "state", "$ref")
+	state := mustLookup(t, schemas, "example.Task.State")
+	assertPath(t, state, "string", "type")
+	assertPath(t, state, []interface{}{"STATE_UNSPECIFIED", "STATE_PENDING", "STATE_RUNNING", "STATE_STARTED"}, "enum")
+	assertPath(t, state, []interface{}{"", "Waiting to be picked up.", "", ""}, "x-enum-descriptions")
+	assertPath(t, state, []interface{}{"STATE_STARTED"}, "x-enum-deprecated")
+	assertNoPath(t, state, "deprecated")
+	assertPath(t, schemas, true, "example.Priority", "deprecated")
+	assertNoPath(t, schemas, "example.Priority", "x-enum-descriptions")
+	assertPath(t, schemas, []interface{}{"UNUSED_UNSPECIFIED"}, "example.Unused", "enum")
+
+	doc = generateYAML(t, map[string]string{"enums.proto": enumProto}, "enum_type=integer,omit_enum_unspecified")
+	schemas = mustLookup(t, doc, "components", "schemas")
+	assertPath(t, schemas, "int32", "example.Task.State", "format")
+	assertPath(t, schemas, []interface{}{0, 1, 2}, "example.Task.State", "enum")
+	assertPath(t, schemas, []interface{}{"", "Waiting to be picked up.", ""}, "example.Task.State", "x-enum-descriptions")
+	assertPath(t, schemas, []interface{}{0, 1}, "example.Priority", "enum")
+
+	doc = generateYAML(t, map[string]string{"enums.proto": enumProto}, "enum_type=both")
+	state = mustLookup(t, doc, "components", "schemas", "example.Task.State")
+	assertPath(t, state, []interface{}{"STATE_UNSPECIFIED", "STATE_PENDING", "STATE_RUNNING", "STATE_STARTED", 0, 1, 2}, "enum")
+	assertPath(t, state, []interface{}{"", "Waiting to be picked up.", "", "", "", "Waiting to be picked up.", ""}, "x-enum-descriptions")
+}
+
+func TestOmitEnumUnspecified(t *testing.T) {
+	sources := map[string]string{
+		"tasks.proto": `
+			syntax = "proto3";
+			package example;
+			import "google/api/annotations.proto";
+			service Tasks {
+				rpc ListTasks(ListTasksRequest) returns (ListTasksResponse) {
+					option (google.api.http) = {get: "/v1/tasks"};
+				}
+				rpc CreateTask(CreateTaskRequest) returns (Task) {
+					option (google.api.http) = {post: "/v1/tasks", body: "task"};
+				}
+			}
+			enum State {
+				STATE_UNSPECIFIED = 0;
+				STATE_PENDING = 1;
+			}
+			enum Unused {
+				UNUSED_UNSPECIFIED = 0;
+			}
+			message Task {
+				string name = 1;
+				State state = 2;
+				repeated State history = 3;
+				Unused unused = 4;
+				Owner owner = 5;
+			}
+			message Owner {
+				string name = 1;
+			}
+			message CreateTaskRequest {
+				Task task = 1;
+			}
+			message ListTasksRequest {
+				State state = 1;
+			}
+			message ListTasksResponse {
+				repeated Task tasks = 1;
+			}
+		`,
+	}
+	doc := generateYAML(t, sources, "omit_enum_unspecified")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	assertPath(t, schemas, []interface{}{"STATE_UNSPECIFIED", "STATE_PENDING"}, "example.State", "enum")
+	assertPath(t, schemas, []interface{}{"STATE_PENDING"}, "example.StateInput", "enum")
+	assertPath(t, schemas, []interface{}{"UNUSED_UNSPECIFIED"}, "example.Unused", "enum")
+	assertNoPath(t, schemas, "example.UnusedInput")
+	assertNoPath(t, schemas, "example.OwnerInput")
+
+	// Responses keep the zero value, requests use the variants leaving it out.
+	assertPath(t, schemas, "#/components/schemas/example.State", "example.Task", "properties", "state", "$ref")
+	assertPath(t, schemas, "#/components/schemas/example.State", "example.Task", "properties", "history", "items", "$ref")
+	assertPath(t, doc, "#/components/schemas/example.TaskInput", "paths", "/v1/tasks", "post", "requestBody", "content", "application/json", "schema", "$ref")
+	task := mustLookup(t, schemas, "example.TaskInput")
+	assertPath(t, task, "#/components/schemas/example.StateInput", "properties", "state", "$ref")
+	assertPath(t, task, "#/components/schemas/example.StateInput", "properties", "history", "items", "$ref")
+	assertPath(t, task, "#/components/schemas/example.Unused", "properties", "unused", "$ref")
+	assertPath(t, task, "#/components/schemas/example.Owner", "properties", "owner", "$ref")
+
+	params := mustLookup(t, doc, "paths", "/v1/tasks", "get", "parameters")
+	assertPath(t, params, []interface{}{"STATE_PENDING"}, 0, "schema", "enum")
+
+	doc = generateYAML(t, sources, "")
+	assertNoPath(t, doc, "components", "schemas", "example.TaskInput")
+	assertPath(t, doc, "#/components/schemas/example.Task", "paths", "/v1/tasks", "post", "requestBody", "content", "application/json", "schema", "$ref")
+}
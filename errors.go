@@ -0,0 +1,110 @@
+package main
+
+import (
+	"strings"
+
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// errorResponse is the key of the response shared by all operations for errors in the components of a document.
+const errorResponse = "Error"
+
+// errorStatusCodes lists the HTTP status codes added with the error_responses option, with the gRPC codes that grpc-gateway maps to them.
+var errorStatusCodes = []struct {
+	status string
+	codes  []string
+}{
+	{"400", []string{"INVALID_ARGUMENT", "FAILED_PRECONDITION", "OUT_OF_RANGE"}},
+	{"401", []string{"UNAUTHENTICATED"}},
+	{"403", []string{"PERMISSION_DENIED"}},
+	{"404", []string{"NOT_FOUND"}},
+	{"409", []string{"ALREADY_EXISTS", "ABORTED"}},
+	{"429", []string{"RESOURCE_EXHAUSTED"}},
+	{"500", []string{"UNKNOWN", "INTERNAL", "DATA_LOSS"}},
+	{"503", []string{"UNAVAILABLE"}},
+}
+
+// addErrorResponses gives every operation of the document a default response referencing a shared response with the google.rpc.Status schema, which grpc-gateway writes for errors. Responses set by annotations are kept.
+func (g *generator) addErrorResponses(d *OpenAPI) {
+	var ops []*Operation
+	for _, item := range d.Paths {
+		for _, op := range []*Operation{item.Value.Get, item.Value.Put, item.Value.Post, item.Value.Delete, item.Value.Options, item.Value.Head, item.Value.Patch, item.Value.Trace} {
+			if op != nil {
+				ops = append(ops, op)
+			}
+		}
+	}
+	if len(ops) == 0 {
+		return
+	}
+	if d.Components.Responses == nil {
+		d.Components.Responses = map[string]ResponseOrReference{}
+	}
+	d.Components.Responses[errorResponse] = Response{
+		Description: "An error, with its gRPC status code, message and details.",
+		Content: map[string]MediaType{
+			"application/json": {Schema: &Schema{Ref: g.statusRef()}},
+		},
+	}
+	ref := "#/components/responses/" + errorResponse
+	for _, op := range ops {
+		if op.Responses.Default == nil {
+			op.Responses.Default = Reference{Ref: ref}
+		}
+		if !g.conf.ErrorResponses {
+			continue
+		}
+		for _, e := range errorStatusCodes {
+			if _, ok := op.Responses.Codes[e.status]; !ok {
+				op.Responses.Codes[e.status] = Reference{Ref: ref, Description: "An error with the gRPC code " + strings.Join(e.codes, ", ") + "."}
+			}
+		}
+	}
+}
+
+// statusRef returns a reference to the schema of google.rpc.Status. The message is described like any other when a file of the request declares it, and by a built-in schema otherwise, as it is rarely imported by the files describing the routes. Either way it is named like the messages of the API, see newNames.
+func (g *generator) statusRef() string {
+	if m := g.findMessage("google.rpc.Status"); m != nil {
+		return g.messageRef(m)
+	}
+	name := g.names.schema((&statuspb.Status{}).ProtoReflect().Descriptor())
+	if _, ok := g.schemas[name]; !ok {
+		s := &Schema{
+			Type:        SchemaType{"object"},
+			Description: "The error model of gRPC, written by grpc-gateway for failed calls.",
+		}
+		s.Properties.Set("code", &Schema{Type: SchemaType{"integer"}, Format: "int32", Description: "The gRPC status code, such as 5 for NOT_FOUND."})
+		s.Properties.Set("message", &Schema{Type: SchemaType{"string"}, Description: "A developer-facing error message in English."})
+		s.Properties.Set("details", &Schema{Type: SchemaType{"array"}, Items: &Schema{Ref: g.anyRef()}, Description: "Messages carrying error details, such as google.rpc.ErrorInfo."})
+		g.schemas[name] = s
+	}
+	return "#/components/schemas/" + name
+}
+
+// anyRef returns a reference to the schema of google.protobuf.Any, adding the schema to the components when no file of the request declares it.
+func (g *generator) anyRef() string {
+	if m := g.findMessage("google.protobuf.Any"); m != nil {
+		return g.messageRef(m)
+	}
+	desc := (&anypb.Any{}).ProtoReflect().Descriptor()
+	name := g.names.schema(desc)
+	if _, ok := g.schemas[name]; !ok {
+		g.schemas[name] = g.wellKnownSchema(desc)
+	}
+	return "#/components/schemas/" + name
+}
+
+// findMessage returns the top-level message of the request with the full name, or nil.
+func (g *generator) findMessage(name protoreflect.FullName) *protogen.Message {
+	for _, f := range g.plugin.Files {
+		for _, m := range f.Messages {
+			if m.Desc.FullName() == name {
+				return m
+			}
+		}
+	}
+	return nil
+}
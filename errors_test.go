@@ -0,0 +1,68 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestErrorResponses(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": libraryProto}, "")
+	assertPath(t, doc, "#/components/schemas/google.rpc.Status", "components", "responses", "Error", "content", "application/json", "schema", "$ref")
+	status := mustLookup(t, doc, "components", "schemas", "google.rpc.Status")
+	assertPath(t, status, "int32", "properties", "code", "format")
+	assertPath(t, status, "string", "properties", "message", "type")
+	assertPath(t, status, "#/components/schemas/google.protobuf.Any", "properties", "details", "items", "$ref")
+	assertPath(t, doc, []interface{}{"@type"}, "components", "schemas", "google.protobuf.Any", "required")
+
+	get := mustLookup(t, doc, "paths", "/v1/shelves/{shelf}/books/{book}", "get", "responses")
+	assertPath(t, get, "#/components/responses/Error", "default", "$ref")
+	assertNoPath(t, get, "404")
+
+	doc = generateYAML(t, map[string]string{"library.proto": libraryProto}, "error_responses=true")
+	get = mustLookup(t, doc, "paths", "/v1/shelves/{shelf}/books/{book}", "get", "responses")
+	for _, code := range []string{"400", "401", "403", "404", "409", "429", "500", "503"} {
+		assertPath(t, get, "#/components/responses/Error", code, "$ref")
+	}
+	assertPath(t, get, "An error with the gRPC code NOT_FOUND.", "404", "description")
+	assertPath(t, get, "#/components/responses/Error", "default", "$ref")
+}
+
+func TestErrorResponsesDeclaredStatus(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"google/rpc/status.proto": `
+			syntax = "proto3";
+			package google.rpc;
+			import "google/protobuf/any.proto";
+			message Status {
+				int32 code = 1;
+				string message = 2;
+				repeated google.protobuf.Any details = 3;
+			}
+		`,
+		"library.proto": libraryProto,
+	}, "")
+	status := mustLookup(t, doc, "components", "schemas", "google.rpc.Status")
+	assertPath(t, status, "#/components/schemas/google.protobuf.Any", "properties", "details", "items", "$ref")
+	mustLookup(t, doc, "components", "schemas", "google.protobuf.Any")
+}
+
+func TestErrorResponsesWithoutOperations(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"search.proto": searchProto}, "")
+	assertNoPath(t, doc, "components", "responses")
+	assertNoPath(t, doc, "components", "schemas", "google.rpc.Status")
+}
+
+func TestErrorResponsesNaming(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": libraryProto}, "naming=short")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	assertPath(t, doc, "#/components/schemas/Status", "components", "responses", "Error", "content", "application/json", "schema", "$ref")
+	assertPath(t, schemas, "#/components/schemas/Any", "Status", "properties", "details", "items", "$ref")
+	assertNoPath(t, schemas, "google.rpc.Status")
+	assertNoPath(t, schemas, "google.protobuf.Any")
+
+	// A Status message of the API collides with google.rpc.Status, and both move on to the next strategy.
+	doc = generateYAML(t, map[string]string{"library.proto": strings.Replace(libraryProto, "message Book {", "message Status {}\n\tmessage Book {\n\t\tStatus status = 3;", 1)}, "naming=short")
+	schemas = mustLookup(t, doc, "components", "schemas")
+	assertPath(t, doc, "#/components/schemas/RpcStatus", "components", "responses", "Error", "content", "application/json", "schema", "$ref")
+	assertPath(t, schemas, "#/components/schemas/V1Status", "Book", "properties", "status", "$ref")
+}
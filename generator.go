@@ -0,0 +1,474 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// generator translates the proto files of an output document into OpenAPI objects.
+type generator struct {
+	plugin *protogen.Plugin
+	conf   *Config
+	doc    *documentFile
+	// owners maps the path of every proto file described by an output document to the name of that document.
+	owners map[string]string
+	// names holds the component schema names and operation ID prefixes shared by all documents.
+	names *names
+	// format is the extension of the documents being written, used by references to other documents.
+	format string
+
+	// schemas holds the component schemas of the document by name. Messages that are registered but not yet translated map to nil.
+	schemas map[string]*Schema
+	// pending holds the registered messages that are waiting to be translated.
+	pending []pendingMessage
+	// warnings holds the problems found in the proto files that did not prevent generating the document.
+	warnings []string
+}
+
+// pendingMessage is a message registered under the component schema name, to be described as requests or responses write it.
+type pendingMessage struct {
+	name    string
+	message *protogen.Message
+	input   bool
+}
+
+func newGenerator(plugin *protogen.Plugin, conf *Config, doc *documentFile, owners map[string]string, names *names, format string) *generator {
+	return &generator{
+		plugin:  plugin,
+		conf:    conf,
+		doc:     doc,
+		owners:  owners,
+		names:   names,
+		format:  format,
+		schemas: map[string]*Schema{},
+	}
+}
+
+// document returns the OpenAPI document describing the files of the generator.
+func (g *generator) document() (*OpenAPI, error) {
+	d := &OpenAPI{
+		OpenAPI:      "3.1.0",
+		Info:         g.conf.document.Info,
+		Servers:      g.conf.document.Servers,
+		ExternalDocs: g.conf.document.ExternalDocs,
+		Security:     g.conf.document.Security,
+		Components:   &Components{},
+	}
+	for name, s := range g.conf.document.Components.SecuritySchemes {
+		if d.Components.SecuritySchemes == nil {
+			d.Components.SecuritySchemes = map[string]SecuritySchemeOrReference{}
+		}
+		d.Components.SecuritySchemes[name] = s
+	}
+	for _, f := range g.doc.files {
+		for _, e := range f.Enums {
+			g.addEnum(e)
+		}
+		for _, m := range f.Messages {
+			g.addMessages(m)
+		}
+	}
+	paths, tags, err := g.paths()
+	if err != nil {
+		return nil, err
+	}
+	d.Paths = paths
+	d.Tags = tags
+	for _, f := range g.doc.files {
+		mergeDocument(d, documentOption(f.Desc))
+	}
+	// The plugin options take precedence over the config file and the annotations.
+	setString(&d.Info.Title, g.conf.Title)
+	setString(&d.Info.Version, g.conf.Version)
+	// The title and version are required, so documents lacking them get values derived from their files.
+	if d.Info.Title == "" {
+		d.Info.Title = defaultTitle(g.doc.files)
+	}
+	if d.Info.Version == "" {
+		d.Info.Version = defaultVersion(g.doc.files)
+	}
+	g.checkSecurity(d)
+	g.addErrorResponses(d)
+	d.Components.Schemas = g.components()
+	if len(d.Components.Schemas) == 0 && len(d.Components.SecuritySchemes) == 0 && len(d.Components.Responses) == 0 {
+		d.Components = nil
+	}
+	return d, nil
+}
+
+// checkSecurity warns about security requirements naming schemes that the document does not declare.
+func (g *generator) checkSecurity(d *OpenAPI) {
+	check := func(where string, requirements []SecurityRequirement) {
+		for _, r := range requirements {
+			for _, name := range sortedMap(r).Keys() {
+				if _, ok := d.Components.SecuritySchemes[name]; !ok {
+					g.warnings = append(g.warnings, fmt.Sprintf("%s: security scheme %q is not declared", where, name))
+				}
+			}
+		}
+	}
+	check(g.doc.name, d.Security)
+	for _, item := range d.Paths {
+		for _, op := range []*Operation{item.Value.Get, item.Value.Put, item.Value.Post, item.Value.Delete, item.Value.Options, item.Value.Head, item.Value.Patch, item.Value.Trace} {
+			if op != nil && op.Security != nil {
+				check(op.OperationID, *op.Security)
+			}
+		}
+	}
+}
+
+// defaultTitle returns the name of the only service of the files, or else the proto package they share, or else API.
+func defaultTitle(files []*protogen.File) string {
+	var services []*protogen.Service
+	for _, f := range files {
+		services = append(services, f.Services...)
+	}
+	if len(services) == 1 {
+		return string(services[0].Desc.Name())
+	}
+	if pkg := commonPackage(files); pkg != "" {
+		return string(pkg)
+	}
+	return "API"
+}
+
+// versionPattern matches the last component of versioned proto packages, such as v1 or v1beta2.
+var versionPattern = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+// defaultVersion returns the version component of the proto package shared by the files, such as v1 for example.v1, or else 0.0.0.
+func defaultVersion(files []*protogen.File) string {
+	if pkg := commonPackage(files); versionPattern.MatchString(string(pkg.Name())) {
+		return string(pkg.Name())
+	}
+	return "0.0.0"
+}
+
+// commonPackage returns the proto package of the files if they all share one, or else an empty name.
+func commonPackage(files []*protogen.File) protoreflect.FullName {
+	var pkg protoreflect.FullName
+	for i, f := range files {
+		if i > 0 && f.Desc.Package() != pkg {
+			return ""
+		}
+		pkg = f.Desc.Package()
+	}
+	return pkg
+}
+
+// addMessages registers the message and its nested messages and enums as component schemas of the document. The synthetic entry messages of map fields are skipped, as maps are described inline.
+func (g *generator) addMessages(m *protogen.Message) {
+	if m.Desc.IsMapEntry() {
+		return
+	}
+	g.addMessage(m)
+	for _, e := range m.Enums {
+		g.addEnum(e)
+	}
+	for _, nested := range m.Messages {
+		g.addMessages(nested)
+	}
+}
+
+// addMessage registers the message as a component schema of the document, unless it already is. Messages are registered before they are translated, so recursive messages are translated once and referenced from within themselves.
+func (g *generator) addMessage(m *protogen.Message) {
+	name := g.names.schema(m.Desc)
+	if _, ok := g.schemas[name]; ok {
+		return
+	}
+	g.schemas[name] = nil
+	g.pending = append(g.pending, pendingMessage{name: name, message: m})
+}
+
+// components translates the registered messages, and the messages they reference in turn, and returns the component schemas sorted by name.
+func (g *generator) components() orderedMap[*Schema] {
+	for len(g.pending) > 0 {
+		p := g.pending[0]
+		g.pending = g.pending[1:]
+		g.schemas[p.name] = g.messageSchema(p.message, p.input)
+	}
+	return sortedMap(g.schemas)
+}
+
+// messageRef returns a reference to the schema of the message. Messages of files described by another document are referenced in that document, all others are added to the components of this one.
+func (g *generator) messageRef(m *protogen.Message) string {
+	return g.schemaRef(m.Desc, func() { g.addMessage(m) })
+}
+
+// enumRef returns a reference to the schema of the enum, like messageRef.
+func (g *generator) enumRef(e *protogen.Enum) string {
+	return g.schemaRef(e.Desc, func() { g.addEnum(e) })
+}
+
+// inputRef returns a reference to the schema of the message as requests write it. With the omit_enum_unspecified option, messages reaching enums with an omitted value are described by a variant of their schema leaving the value out, as the value is only omitted from requests. All other messages share the schema of responses.
+func (g *generator) inputRef(m *protogen.Message) string {
+	if !g.reachesOmitted(m, nil) {
+		return g.messageRef(m)
+	}
+	name := g.inputName(m.Desc)
+	if name == "" {
+		return g.messageRef(m)
+	}
+	if _, ok := g.schemas[name]; !ok {
+		g.schemas[name] = nil
+		g.pending = append(g.pending, pendingMessage{name: name, message: m, input: true})
+	}
+	return "#/components/schemas/" + name
+}
+
+// inputEnumRef returns a reference to the schema of the enum as requests write it, like inputRef.
+func (g *generator) inputEnumRef(e *protogen.Enum) string {
+	if !g.owned(e.Desc) || g.omittedValue(e) == nil {
+		return g.enumRef(e)
+	}
+	name := g.inputName(e.Desc)
+	if name == "" {
+		return g.enumRef(e)
+	}
+	if _, ok := g.schemas[name]; !ok {
+		g.schemas[name] = g.enumSchema(e, true)
+	}
+	return "#/components/schemas/" + name
+}
+
+// reachesOmitted reports whether the message, or a message it reaches, has an enum field with a value omitted from requests. Types described by another document are not followed, as requests share their schemas.
+func (g *generator) reachesOmitted(m *protogen.Message, visited []*protogen.Message) bool {
+	if !g.conf.OmitEnumUnspecified || !g.owned(m.Desc) || g.wellKnownSchema(m.Desc) != nil {
+		return false
+	}
+	for _, v := range visited {
+		if v == m {
+			return false
+		}
+	}
+	visited = append(visited, m)
+	for _, f := range m.Fields {
+		if f.Enum != nil && g.owned(f.Enum.Desc) && g.omittedValue(f.Enum) != nil {
+			return true
+		}
+		if f.Message != nil && g.reachesOmitted(f.Message, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// inputName returns the name of the request variant of the schema of the message or enum: its name with an Input suffix. It returns an empty name, and requests share the schema of responses, if another type already has that name.
+func (g *generator) inputName(desc protoreflect.Descriptor) string {
+	name := g.names.schema(desc) + "Input"
+	if g.names.taken(name) {
+		g.warnings = append(g.warnings, fmt.Sprintf("%s: schema name %q is taken, requests use the schema of responses", desc.FullName(), name))
+		return ""
+	}
+	return name
+}
+
+// owned reports whether the message or enum is described by this document rather than referenced in another one.
+func (g *generator) owned(desc protoreflect.Descriptor) bool {
+	owner, ok := g.owners[desc.ParentFile().Path()]
+	return !ok || owner == g.doc.name
+}
+
+// schemaRef returns a reference to the component schema of the message or enum, calling add to register it when it belongs in the components of this document.
+func (g *generator) schemaRef(desc protoreflect.Descriptor, add func()) string {
+	if g.owned(desc) {
+		add()
+		return "#/components/schemas/" + g.names.schema(desc)
+	}
+	return relativePath(path.Dir(g.doc.name), g.owners[desc.ParentFile().Path()]+"."+g.format) + "#/components/schemas/" + g.names.schema(desc)
+}
+
+// messageSchema returns the object schema describing the JSON form of the message, as requests write it if input is set, or else as responses do.
+func (g *generator) messageSchema(m *protogen.Message, input bool) *Schema {
+	if s := g.wellKnownSchema(m.Desc); s != nil {
+		return s
+	}
+	s := &Schema{Type: SchemaType{"object"}, Description: g.description(m.Comments)}
+	for _, f := range m.Fields {
+		name := g.propertyName(f)
+		fs := g.fieldSchema(f, input)
+		fs.Description = g.description(f.Comments)
+		addFieldBehavior(s, name, fs, f)
+		mergeSchema(fs, propertyOption(f.Desc))
+		s.Properties.Set(name, fs)
+	}
+	g.addOneofs(s, m)
+	mergeSchema(s, schemaOption(m.Desc))
+	return s
+}
+
+// addFieldBehavior describes the google.api.field_behavior annotations of the field, as used by AIP-203, on the schema of its message and its property schema.
+func addFieldBehavior(s *Schema, name string, fs *Schema, f *protogen.Field) {
+	behaviors, _ := proto.GetExtension(f.Desc.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, b := range behaviors {
+		switch b {
+		case annotations.FieldBehavior_REQUIRED:
+			s.Required = append(s.Required, name)
+		case annotations.FieldBehavior_OUTPUT_ONLY:
+			fs.ReadOnly = true
+		case annotations.FieldBehavior_INPUT_ONLY:
+			fs.WriteOnly = true
+		case annotations.FieldBehavior_IMMUTABLE:
+			fs.Extensions.Set("x-immutable", true)
+		}
+	}
+}
+
+// addOneofs describes the oneof groups of the message on its schema. The synthetic oneofs of proto3 optional fields are not groups: those fields are ordinary optional properties.
+func (g *generator) addOneofs(s *Schema, m *protogen.Message) {
+	var groups []*Schema
+	var annotations orderedMap[[]string]
+	for _, o := range m.Oneofs {
+		if o.Desc.IsSynthetic() {
+			continue
+		}
+		var names []string
+		for _, f := range o.Fields {
+			names = append(names, g.propertyName(f))
+		}
+		if g.conf.OneofStyle == "annotated" {
+			annotations.Set(string(o.Desc.Name()), names)
+			continue
+		}
+		groups = append(groups, exclusiveSchema(names))
+	}
+	switch {
+	case len(groups) == 1:
+		s.OneOf = groups[0].OneOf
+	case len(groups) > 1:
+		s.AllOf = groups
+	}
+	if len(annotations) > 0 {
+		s.Extensions.Set("x-oneof", annotations)
+	}
+}
+
+// exclusiveSchema returns a schema accepting objects with at most one of the properties: either exactly one of them is required, or none of them may be present.
+func exclusiveSchema(names []string) *Schema {
+	s := &Schema{}
+	none := &Schema{}
+	for _, name := range names {
+		s.OneOf = append(s.OneOf, &Schema{Required: []string{name}})
+		none.AnyOf = append(none.AnyOf, &Schema{Required: []string{name}})
+	}
+	s.OneOf = append(s.OneOf, &Schema{Not: none})
+	return s
+}
+
+// propertyName returns the name of the field in the JSON form of its message: its JSON name, as protojson writes by default, or its proto name with the use_proto_names option.
+func (g *generator) propertyName(f *protogen.Field) string {
+	if g.conf.UseProtoNames {
+		return string(f.Desc.Name())
+	}
+	return f.Desc.JSONName()
+}
+
+// fieldSchema returns the schema of a field, wrapping it in an array for repeated fields and in an object for map fields. Input selects the schemas of requests, see inputRef.
+func (g *generator) fieldSchema(f *protogen.Field, input bool) *Schema {
+	if f.Desc.IsMap() {
+		return g.mapSchema(f.Message.Fields[0], f.Message.Fields[1], input)
+	}
+	s := g.kindSchema(f, input)
+	if f.Desc.Cardinality() == protoreflect.Repeated {
+		return &Schema{Type: SchemaType{"array"}, Items: s}
+	}
+	return s
+}
+
+// mapSchema returns the schema of a map field with the key and value fields of its entry message. The JSON object keys are the map keys in string form, so keys of other kinds are constrained with a pattern.
+func (g *generator) mapSchema(key, value *protogen.Field, input bool) *Schema {
+	s := &Schema{
+		Type:                 SchemaType{"object"},
+		AdditionalProperties: &SchemaOrBool{Schema: g.kindSchema(value, input)},
+	}
+	switch key.Desc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		s.PropertyNames = &Schema{Pattern: "^-?[0-9]+$"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		s.PropertyNames = &Schema{Pattern: "^[0-9]+$"}
+	case protoreflect.BoolKind:
+		s.PropertyNames = &Schema{Pattern: "^(true|false)$"}
+	}
+	return s
+}
+
+// kindSchema returns the schema of a single value of the field.
+func (g *generator) kindSchema(f *protogen.Field, input bool) *Schema {
+	switch f.Desc.Kind() {
+	case protoreflect.EnumKind:
+		if f.Enum.Desc.FullName() == "google.protobuf.NullValue" {
+			return &Schema{Type: SchemaType{"null"}}
+		}
+		if input {
+			return &Schema{Ref: g.inputEnumRef(f.Enum)}
+		}
+		return &Schema{Ref: g.enumRef(f.Enum)}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if input {
+			return &Schema{Ref: g.inputRef(f.Message)}
+		}
+		return &Schema{Ref: g.messageRef(f.Message)}
+	}
+	return g.scalarSchema(f.Desc.Kind())
+}
+
+// scalarSchema returns the schema of the proto3 JSON form of a scalar value of the kind.
+func (g *generator) scalarSchema(kind protoreflect.Kind) *Schema {
+	switch kind {
+	case protoreflect.BoolKind:
+		return &Schema{Type: SchemaType{"boolean"}}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: SchemaType{"integer"}, Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: SchemaType{"integer"}, Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return g.int64Schema("int64")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return g.int64Schema("uint64")
+	case protoreflect.FloatKind:
+		return floatSchema("float")
+	case protoreflect.DoubleKind:
+		return floatSchema("double")
+	case protoreflect.StringKind:
+		return &Schema{Type: SchemaType{"string"}}
+	case protoreflect.BytesKind:
+		return &Schema{Type: SchemaType{"string"}, Format: "byte"}
+	}
+	return &Schema{}
+}
+
+// int64Schema returns the schema of a 64-bit integer. protojson writes them as decimal strings, because JavaScript numbers cannot hold them without losing precision.
+func (g *generator) int64Schema(format string) *Schema {
+	if g.conf.Int64Type == "integer" {
+		return &Schema{Type: SchemaType{"integer"}, Format: format}
+	}
+	return &Schema{Type: SchemaType{"string"}, Format: format}
+}
+
+// floatSchema returns the schema of a floating point number, which protojson writes as the strings "NaN", "Infinity" and "-Infinity" when it is not finite.
+func floatSchema(format string) *Schema {
+	return &Schema{OneOf: []*Schema{
+		{Type: SchemaType{"number"}, Format: format},
+		{Type: SchemaType{"string"}, Enum: []interface{}{"NaN", "Infinity", "-Infinity"}},
+	}}
+}
+
+// relativePath returns the slash-separated path of target relative to the directory dir.
+func relativePath(dir, target string) string {
+	from := strings.Split(path.Clean(dir), "/")
+	to := strings.Split(path.Clean(target), "/")
+	if from[0] == "." {
+		from = nil
+	}
+	for len(from) > 0 && len(to) > 1 && from[0] == to[0] {
+		from, to = from[1:], to[1:]
+	}
+	return strings.Repeat("../", len(from)) + strings.Join(to, "/")
+}
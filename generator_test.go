@@ -0,0 +1,452 @@
+package main
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestMessageSchemas(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"example.proto": `
+			syntax = "proto3";
+			package example;
+			message SearchRequest {
+				string query = 1;
+				int32 page_number = 2;
+				repeated double scores = 3;
+				Filter filter = 4;
+				message Filter {
+					bool archived = 1;
+					bytes token = 2;
+				}
+			}
+		`,
+	}, "")
+	assertPath(t, doc, "object", "components", "schemas", "example.SearchRequest", "type")
+	props := mustLookup(t, doc, "components", "schemas", "example.SearchRequest", "properties")
+	assertPath(t, props, "string", "query", "type")
+	assertPath(t, props, "integer", "pageNumber", "type")
+	assertPath(t, props, "int32", "pageNumber", "format")
+	assertPath(t, props, "array", "scores", "type")
+	assertPath(t, props, "number", "scores", "items", "oneOf", 0, "type")
+	assertPath(t, props, "#/components/schemas/example.SearchRequest.Filter", "filter", "$ref")
+	assertPath(t, doc, "boolean", "components", "schemas", "example.SearchRequest.Filter", "properties", "archived", "type")
+}
+
+func TestRelativePath(t *testing.T) {
+	for _, tt := range []struct {
+		dir, target, want string
+	}{
+		{".", "openapi.yaml", "openapi.yaml"},
+		{".", "a/b.yaml", "a/b.yaml"},
+		{"a", "a/b.yaml", "b.yaml"},
+		{"a/v1", "b/v1/c.yaml", "../../b/v1/c.yaml"},
+		{"a/v1", "a/v2/c.yaml", "../v2/c.yaml"},
+		{"a/v1", "c.yaml", "../../c.yaml"},
+	} {
+		if got := relativePath(tt.dir, tt.target); got != tt.want {
+			t.Errorf("relativePath(%q, %q) = %q, want %q", tt.dir, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestReachableSchemas(t *testing.T) {
+	sources := map[string]string{
+		"library.proto": `
+			syntax = "proto3";
+			package example.v1;
+			import "google/api/annotations.proto";
+			import "common.proto";
+			service Library {
+				rpc GetShelf(GetShelfRequest) returns (common.Shelf) {
+					option (google.api.http) = {get: "/v1/{name=shelves/*}"};
+				}
+			}
+			message GetShelfRequest {
+				string name = 1;
+			}
+			message Unused {
+				string name = 1;
+			}
+		`,
+		"common.proto": `
+			syntax = "proto3";
+			package common;
+			import "google/api/annotations.proto";
+			service Admin {
+				rpc Reset(Shelf) returns (Shelf) {
+					option (google.api.http) = {post: "/v1/reset"};
+				}
+			}
+			message Shelf {
+				string name = 1;
+				Owner owner = 2;
+			}
+			message Owner {
+				string name = 1;
+			}
+			message Unreferenced {
+				string name = 1;
+			}
+		`,
+	}
+	req := newRequest(t, sources, "")
+	req.FileToGenerate = []string{"library.proto"}
+	out := runPlugin(t, req)
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(out["openapi.yaml"]), &doc); err != nil {
+		t.Fatal(err)
+	}
+	mustLookup(t, doc, "paths", "/v1/shelves/{shelf}", "get")
+	assertNoPath(t, doc, "paths", "/v1/reset")
+	for _, name := range []string{"example.v1.GetShelfRequest", "example.v1.Unused", "common.Shelf", "common.Owner"} {
+		mustLookup(t, doc, "components", "schemas", name)
+	}
+	for _, name := range []string{"common.Unreferenced", "google.api.HttpRule", "google.protobuf.MethodOptions"} {
+		assertNoPath(t, doc, "components", "schemas", name)
+	}
+}
+
+func TestWellKnownTypes(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"event.proto": `
+			syntax = "proto3";
+			package example;
+			import "google/protobuf/any.proto";
+			import "google/protobuf/duration.proto";
+			import "google/protobuf/struct.proto";
+			import "google/protobuf/timestamp.proto";
+			import "google/protobuf/wrappers.proto";
+			message Event {
+				google.protobuf.Timestamp time = 1;
+				google.protobuf.Duration duration = 2;
+				google.protobuf.Struct labels = 3;
+				google.protobuf.Any detail = 4;
+				google.protobuf.Int64Value count = 5;
+				google.protobuf.BoolValue flag = 6;
+				google.protobuf.NullValue nothing = 7;
+			}
+		`,
+	}, "")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	assertPath(t, schemas, "#/components/schemas/google.protobuf.Timestamp", "example.Event", "properties", "time", "$ref")
+	assertPath(t, schemas, "null", "example.Event", "properties", "nothing", "type")
+	assertPath(t, schemas, "string", "google.protobuf.Timestamp", "type")
+	assertPath(t, schemas, "date-time", "google.protobuf.Timestamp", "format")
+	assertNoPath(t, schemas, "google.protobuf.Timestamp", "properties")
+	assertPath(t, schemas, "string", "google.protobuf.Duration", "type")
+	assertPath(t, schemas, true, "google.protobuf.Struct", "additionalProperties")
+	assertPath(t, schemas, "string", "google.protobuf.Any", "properties", "@type", "type")
+	assertPath(t, schemas, []interface{}{"string", "null"}, "google.protobuf.Int64Value", "type")
+	assertPath(t, schemas, "int64", "google.protobuf.Int64Value", "format")
+	assertPath(t, schemas, []interface{}{"boolean", "null"}, "google.protobuf.BoolValue", "type")
+	// Only the well-known types that are referenced are added.
+	assertNoPath(t, schemas, "google.protobuf.StringValue")
+	assertNoPath(t, schemas, "google.protobuf.ListValue")
+}
+
+func TestScalarEncoding(t *testing.T) {
+	sources := map[string]string{
+		"scalars.proto": `
+			syntax = "proto3";
+			package example;
+			import "google/protobuf/wrappers.proto";
+			message Scalars {
+				int64 id = 1;
+				fixed64 checksum = 2;
+				bytes data = 3;
+				float ratio = 4;
+				Kind kind = 5;
+				google.protobuf.UInt64Value size = 6;
+				uint32 count = 7;
+			}
+			enum Kind {
+				KIND_UNSPECIFIED = 0;
+			}
+		`,
+	}
+	doc := generateYAML(t, sources, "")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	props := mustLookup(t, schemas, "example.Scalars", "properties")
+	assertPath(t, props, "string", "id", "type")
+	assertPath(t, props, "int64", "id", "format")
+	assertPath(t, props, "string", "checksum", "type")
+	assertPath(t, props, "uint64", "checksum", "format")
+	assertPath(t, props, "string", "data", "type")
+	assertPath(t, props, "byte", "data", "format")
+	assertPath(t, props, "float", "ratio", "oneOf", 0, "format")
+	assertPath(t, props, []interface{}{"NaN", "Infinity", "-Infinity"}, "ratio", "oneOf", 1, "enum")
+	assertPath(t, schemas, "string", "example.Kind", "type")
+	assertPath(t, props, "integer", "count", "type")
+	assertPath(t, schemas, []interface{}{"string", "null"}, "google.protobuf.UInt64Value", "type")
+
+	doc = generateYAML(t, sources, "int64_type=integer,enum_type=both")
+	schemas = mustLookup(t, doc, "components", "schemas")
+	props = mustLookup(t, schemas, "example.Scalars", "properties")
+	assertPath(t, props, "integer", "id", "type")
+	assertPath(t, props, "integer", "checksum", "type")
+	assertPath(t, schemas, []interface{}{"string", "integer"}, "example.Kind", "type")
+	assertPath(t, schemas, []interface{}{"integer", "null"}, "google.protobuf.UInt64Value", "type")
+}
+
+func TestMapFields(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"config.proto": `
+			syntax = "proto3";
+			package example;
+			message Config {
+				map<string, Setting> settings = 1;
+				map<int64, string> names = 2;
+				map<uint32, bool> flags = 3;
+				map<bool, int32> counts = 4;
+			}
+			message Setting {
+				string value = 1;
+			}
+		`,
+	}, "")
+	schemas := mustLookup(t, doc, "components", "schemas")
+	props := mustLookup(t, schemas, "example.Config", "properties")
+	assertPath(t, props, "object", "settings", "type")
+	assertPath(t, props, "#/components/schemas/example.Setting", "settings", "additionalProperties", "$ref")
+	assertNoPath(t, props, "settings", "propertyNames")
+	assertPath(t, props, "string", "names", "additionalProperties", "type")
+	assertPath(t, props, "^-?[0-9]+$", "names", "propertyNames", "pattern")
+	assertPath(t, props, "^[0-9]+$", "flags", "propertyNames", "pattern")
+	assertPath(t, props, "^(true|false)$", "counts", "propertyNames", "pattern")
+	assertNoPath(t, schemas, "example.Config.SettingsEntry")
+	assertNoPath(t, schemas, "example.Config.NamesEntry")
+}
+
+const oneofProto = `
+	syntax = "proto3";
+	package example;
+	message Payment {
+		optional string note = 1;
+		oneof method {
+			string card = 2;
+			string iban = 3;
+		}
+	}
+	message Shipment {
+		oneof origin {
+			string warehouse = 1;
+			string store = 2;
+		}
+		oneof destination {
+			string address = 3;
+			string locker = 4;
+		}
+	}
+`
+
+func TestOneofs(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"payment.proto": oneofProto}, "")
+	payment := mustLookup(t, doc, "components", "schemas", "example.Payment")
+	for _, name := range []string{"note", "card", "iban"} {
+		mustLookup(t, payment, "properties", name)
+	}
+	assertPath(t, payment, []interface{}{"card"}, "oneOf", 0, "required")
+	assertPath(t, payment, []interface{}{"iban"}, "oneOf", 1, "required")
+	assertPath(t, payment, []interface{}{"card"}, "oneOf", 2, "not", "anyOf", 0, "required")
+	assertPath(t, payment, []interface{}{"iban"}, "oneOf", 2, "not", "anyOf", 1, "required")
+	// The synthetic oneof of the proto3 optional field is not a group.
+	if oneOf := mustLookup(t, payment, "oneOf").([]interface{}); len(oneOf) != 3 {
+		t.Errorf("got %d oneOf branches, want 3", len(oneOf))
+	}
+	assertNoPath(t, payment, "x-oneof")
+
+	shipment := mustLookup(t, doc, "components", "schemas", "example.Shipment")
+	assertPath(t, shipment, []interface{}{"warehouse"}, "allOf", 0, "oneOf", 0, "required")
+	assertPath(t, shipment, []interface{}{"address"}, "allOf", 1, "oneOf", 0, "required")
+	assertNoPath(t, shipment, "oneOf")
+}
+
+func TestOneofsAnnotated(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"payment.proto": oneofProto}, "oneof_style=annotated")
+	payment := mustLookup(t, doc, "components", "schemas", "example.Payment")
+	assertPath(t, payment, []interface{}{"card", "iban"}, "x-oneof", "method")
+	assertNoPath(t, payment, "x-oneof", "_note")
+	assertNoPath(t, payment, "oneOf")
+	shipment := mustLookup(t, doc, "components", "schemas", "example.Shipment")
+	assertPath(t, shipment, []interface{}{"address", "locker"}, "x-oneof", "destination")
+	assertNoPath(t, shipment, "allOf")
+}
+
+func TestRecursiveMessages(t *testing.T) {
+	sources := map[string]string{
+		"tree.proto": `
+			syntax = "proto3";
+			package example;
+			import "org.proto";
+			message Root {
+				org.Employee ceo = 1;
+			}
+			message TreeNode {
+				string value = 1;
+				repeated TreeNode children = 2;
+				map<string, TreeNode> named = 3;
+				oneof parent {
+					TreeNode node = 4;
+					Expr expr = 5;
+				}
+			}
+			message Expr {
+				oneof kind {
+					Binary binary = 1;
+					int64 literal = 2;
+				}
+			}
+			message Binary {
+				Expr left = 1;
+				Expr right = 2;
+			}
+		`,
+		"org.proto": `
+			syntax = "proto3";
+			package org;
+			message Employee {
+				string name = 1;
+				Employee manager = 2;
+				repeated Team teams = 3;
+			}
+			message Team {
+				repeated Employee members = 1;
+			}
+		`,
+	}
+	req := newRequest(t, sources, "")
+	req.FileToGenerate = []string{"tree.proto"}
+	out := runPlugin(t, req)
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(out["openapi.yaml"]), &doc); err != nil {
+		t.Fatal(err)
+	}
+	schemas := mustLookup(t, doc, "components", "schemas")
+	if n := len(schemas.(map[string]interface{})); n != 6 {
+		t.Errorf("got %d schemas, want 6", n)
+	}
+	assertPath(t, schemas, "#/components/schemas/example.TreeNode", "example.TreeNode", "properties", "children", "items", "$ref")
+	assertPath(t, schemas, "#/components/schemas/example.TreeNode", "example.TreeNode", "properties", "named", "additionalProperties", "$ref")
+	assertPath(t, schemas, "#/components/schemas/example.TreeNode", "example.TreeNode", "properties", "node", "$ref")
+	assertPath(t, schemas, "#/components/schemas/example.Expr", "example.Binary", "properties", "left", "$ref")
+	assertPath(t, schemas, "#/components/schemas/example.Binary", "example.Expr", "properties", "binary", "$ref")
+	assertPath(t, schemas, "#/components/schemas/org.Employee", "org.Employee", "properties", "manager", "$ref")
+	assertPath(t, schemas, "#/components/schemas/org.Team", "org.Employee", "properties", "teams", "items", "$ref")
+	assertPath(t, schemas, "#/components/schemas/org.Employee", "org.Team", "properties", "members", "items", "$ref")
+}
+
+func TestRecursiveMessagesAcrossDocuments(t *testing.T) {
+	// The packages reference each other through three files, without an import cycle.
+	sources := map[string]string{
+		"a/person.proto": `
+			syntax = "proto3";
+			package a;
+			import "b/address.proto";
+			message Person {
+				b.Address home = 1;
+			}
+		`,
+		"b/address.proto": `
+			syntax = "proto3";
+			package b;
+			message Address {
+				string street = 1;
+			}
+		`,
+		"b/household.proto": `
+			syntax = "proto3";
+			package b;
+			import "a/person.proto";
+			import "b/address.proto";
+			message Household {
+				repeated a.Person members = 1;
+				Address address = 2;
+			}
+		`,
+	}
+	out := runPlugin(t, newRequest(t, sources, "output_mode=package"))
+	a := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(out["a/openapi.yaml"]), &a); err != nil {
+		t.Fatal(err)
+	}
+	b := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(out["b/openapi.yaml"]), &b); err != nil {
+		t.Fatal(err)
+	}
+	assertPath(t, a, "../b/openapi.yaml#/components/schemas/b.Address", "components", "schemas", "a.Person", "properties", "home", "$ref")
+	assertPath(t, b, "../a/openapi.yaml#/components/schemas/a.Person", "components", "schemas", "b.Household", "properties", "members", "items", "$ref")
+	assertNoPath(t, a, "components", "schemas", "b.Address")
+	assertNoPath(t, b, "components", "schemas", "a.Person")
+}
+
+func TestFieldBehavior(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"book.proto": `
+			syntax = "proto3";
+			package example;
+			import "google/api/field_behavior.proto";
+			message Book {
+				string name = 1 [(google.api.field_behavior) = IMMUTABLE];
+				string title = 2 [(google.api.field_behavior) = REQUIRED];
+				Book sequel = 3 [(google.api.field_behavior) = OUTPUT_ONLY];
+				string etag = 4 [(google.api.field_behavior) = INPUT_ONLY];
+				repeated string authors = 5 [(google.api.field_behavior) = REQUIRED, (google.api.field_behavior) = UNORDERED_LIST];
+				string summary = 6;
+			}
+		`,
+	}, "")
+	book := mustLookup(t, doc, "components", "schemas", "example.Book")
+	assertPath(t, book, []interface{}{"title", "authors"}, "required")
+	assertPath(t, book, true, "properties", "name", "x-immutable")
+	assertPath(t, book, true, "properties", "sequel", "readOnly")
+	assertPath(t, book, "#/components/schemas/example.Book", "properties", "sequel", "$ref")
+	assertPath(t, book, true, "properties", "etag", "writeOnly")
+	for _, name := range []string{"title", "summary"} {
+		assertNoPath(t, book, "properties", name, "readOnly")
+		assertNoPath(t, book, "properties", name, "writeOnly")
+		assertNoPath(t, book, "properties", name, "x-immutable")
+	}
+}
+
+func TestPropertyNames(t *testing.T) {
+	sources := map[string]string{
+		"book.proto": `
+			syntax = "proto3";
+			package example;
+			import "google/api/annotations.proto";
+			service Library {
+				rpc UpdateBook(UpdateBookRequest) returns (Book) {
+					option (google.api.http) = {patch: "/v1/{book.resource_name}", body: "book"};
+				}
+			}
+			message Book {
+				string resource_name = 1;
+				string isbn_13 = 2 [json_name = "ISBN"];
+				oneof edition {
+					int32 edition_number = 3;
+					string edition_name = 4;
+				}
+			}
+			message UpdateBookRequest {
+				Book book = 1;
+			}
+		`,
+	}
+	doc := generateYAML(t, sources, "")
+	book := mustLookup(t, doc, "components", "schemas", "example.Book")
+	for _, name := range []string{"resourceName", "ISBN", "editionNumber", "editionName"} {
+		mustLookup(t, book, "properties", name)
+	}
+	assertPath(t, book, []interface{}{"editionNumber"}, "oneOf", 0, "required")
+	assertPath(t, doc, "book.resource_name", "paths", "/v1/{book.resource_name}", "patch", "parameters", 0, "name")
+
+	doc = generateYAML(t, sources, "use_proto_names=true")
+	book = mustLookup(t, doc, "components", "schemas", "example.Book")
+	for _, name := range []string{"resource_name", "isbn_13", "edition_number", "edition_name"} {
+		mustLookup(t, book, "properties", name)
+	}
+	assertPath(t, book, []interface{}{"edition_number"}, "oneOf", 0, "required")
+	assertPath(t, doc, "book.resource_name", "paths", "/v1/{book.resource_name}", "patch", "parameters", 0, "name")
+}
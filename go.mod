@@ -2,6 +2,16 @@ module github.com/a27kash/protoc-gen-openapi
 
 go 1.18
 
-require google.golang.org/protobuf v1.28.1
+require google.golang.org/protobuf v1.31.0
 
-require gopkg.in/yaml.v3 v3.0.1
+require (
+	github.com/bufbuild/protocompile v0.6.0
+	google.golang.org/genproto/googleapis/api v0.0.0-20230711160842-782d3b101e98
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20230711160842-782d3b101e98
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	golang.org/x/sync v0.3.0 // indirect
+	google.golang.org/genproto v0.0.0-20230706204954-ccb25ca9f130 // indirect
+)
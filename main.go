@@ -1,9 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gopkg.in/yaml.v3"
 )
 
@@ -38,9 +44,9 @@ type Info struct {
 	// A URL to the Terms of Service for the API. This MUST be in the form of a URL.
 	TermsOfService string `yaml:"termsOfService,omitempty" json:"termsOfService,omitempty"`
 	// The contact information for the exposed API.
-	Contact Contact `yaml:"contact,omitempty" json:"contact,omitempty"`
+	Contact *Contact `yaml:"contact,omitempty" json:"contact,omitempty"`
 	// The license information for the exposed API.
-	License License `yaml:"license,omitempty" json:"license,omitempty"`
+	License *License `yaml:"license,omitempty" json:"license,omitempty"`
 	// REQUIRED. The version of the OpenAPI document (which is distinct from the OpenAPI Specification version or the API implementation version).
 	Version string `yaml:"version,omitempty" json:"version,omitempty"`
 }
@@ -66,7 +72,8 @@ type Server struct {
 }
 
 // Paths holds the relative paths to the individual endpoints and their operations. The path is appended to the URL from the Server Object in order to construct the full URL. The Paths MAY be empty, due to Access Control List (ACL) constraints.
-type Paths struct {}
+// The paths are kept in the order in which they were added, which is the declaration order of the methods routed to them.
+type Paths = orderedMap[*PathItem]
 
 // ExternalDocumentation allows referencing an external resource for extended documentation.
 type ExternalDocumentation struct {
@@ -91,10 +98,43 @@ type ResponseOrReference interface {
 	isResponseOrReference()
 }
 
-// Responses is a container for the expected responses of an operation. The container maps a HTTP response code to the expected response.
+// RequestBody describes a single request body.
+type RequestBody struct {
+	// A brief description of the request body. This could contain examples of use. CommonMark syntax MAY be used for rich text representation.
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// REQUIRED. The content of the request body. The key is a media type or media type range and the value describes it. For requests that match multiple keys, only the most specific key is applicable. e.g. text/plain overrides text/*
+	Content map[string]MediaType `yaml:"content,omitempty" json:"content,omitempty"`
+	// Determines if the request body is required in the request. Defaults to false.
+	Required bool `yaml:"required,omitempty" json:"required,omitempty"`
+}
+
+func (r RequestBody) isRequestBodyOrReference() {}
+
+// Responses is a container for the expected responses of an operation. The container maps a HTTP response code, or a range of codes such as 4XX, to the expected response.
 type Responses struct {
 	// The documentation of responses other than the ones declared for specific HTTP response codes. Use this field to cover undeclared responses.
 	Default	ResponseOrReference	`yaml:"default,omitempty" json:"default,omitempty"`
+	// The expected responses keyed by HTTP status code, or by range of status codes written with an uppercase wildcard such as 2XX.
+	Codes map[string]ResponseOrReference `yaml:"-" json:"-"`
+}
+
+// entries returns the responses keyed by status code in ascending order, followed by the default response. A range sorts after the codes it covers, as X sorts after digits.
+func (r Responses) entries() orderedMap[ResponseOrReference] {
+	m := sortedMap(r.Codes)
+	if r.Default != nil {
+		m.Set("default", r.Default)
+	}
+	return m
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (r Responses) MarshalYAML() (interface{}, error) {
+	return r.entries().MarshalYAML()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r Responses) MarshalJSON() ([]byte, error) {
+	return r.entries().MarshalJSON()
 }
 
 // CallbackOrReference ...
@@ -111,7 +151,7 @@ type Operation struct {
 	// A verbose explanation of the operation behavior. CommonMark syntax MAY be used for rich text representation.
 	Description	string	`yaml:"description,omitempty" json:"description,omitempty"`
 	// Additional external documentation for this operation.
-	ExternalDocs	ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
+	ExternalDocs	*ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
 	// Unique string used to identify the operation. The id MUST be unique among all operations described in the API. The operationId value is case-sensitive. Tools and libraries MAY use the operationId to uniquely identify an operation, therefore, it is RECOMMENDED to follow common programming naming conventions.
 	OperationID	string	`yaml:"operationId,omitempty" json:"operationId,omitempty"`
 	// A list of parameters that are applicable for this operation. If a parameter is already defined at the Path Item, the new definition will override it but can never remove it. The list MUST NOT include duplicated parameters. A unique parameter is defined by a combination of a name and location. The list can use the Reference Object to link to parameters that are defined at the OpenAPI Object’s components/parameters.
@@ -119,13 +159,14 @@ type Operation struct {
 	// The request body applicable for this operation. The requestBody is fully supported in HTTP methods where the HTTP 1.1 specification [RFC7231] has explicitly defined semantics for request bodies. In other cases where the HTTP spec is vague (such as GET, HEAD and DELETE), requestBody is permitted but does not have well-defined semantics and SHOULD be avoided if possible.
 	RequestBody	RequestBodyOrReference	`yaml:"requestBody,omitempty" json:"requestBody,omitempty"`
 	// The list of possible responses as they are returned from executing this operation.
-	Responses	Responses	`yaml:"responses,omitempty" json:"responses,omitempty"`
+	Responses	*Responses	`yaml:"responses,omitempty" json:"responses,omitempty"`
 	// A map of possible out-of band callbacks related to the parent operation. The key is a unique identifier for the Callback Object. Each value in the map is a Callback Object that describes a request that may be initiated by the API provider and the expected responses.
 	Callbacks	map[string]CallbackOrReference	`yaml:"callbacks,omitempty" json:"callbacks,omitempty"`
 	// Declares this operation to be deprecated. Consumers SHOULD refrain from usage of the declared operation. Default value is false.
 	Deprecated	bool	`yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
 	// A declaration of which security mechanisms can be used for this operation. The list of values includes alternative security requirement objects that can be used. Only one of the security requirement objects need to be satisfied to authorize a request. To make security optional, an empty security requirement ({}) can be included in the array. This definition overrides any declared top-level security. To remove a top-level security declaration, an empty array can be used.
-	Security	[]SecurityRequirement	`yaml:"security,omitempty" json:"security,omitempty"`
+	// A nil list inherits the top-level security, while an empty list is written out to remove it.
+	Security	*[]SecurityRequirement	`yaml:"security,omitempty" json:"security,omitempty"`
 	// An alternative server array to service this operation. If an alternative server object is specified at the Path Item Object or Root level, it will be overridden by this value.
 	Servers	[]Server	`yaml:"servers,omitempty" json:"servers,omitempty"`
 }
@@ -143,18 +184,26 @@ type PathItem struct {
 	Summary	string	`yaml:"summary,omitempty" json:"summary,omitempty"`
 	// An optional, string description, intended to apply to all operations in this path. CommonMark syntax MAY be used for rich text representation.
 	Description	string	`yaml:"description,omitempty" json:"description,omitempty"`
-
-
-	Get	Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Put	Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Post Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Delete Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Options	Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Head	Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Patch	Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Trace	Operation	`yaml:"url,omitempty" json:"url,omitempty"`
-	Servers	[]Server	`yaml:"url,omitempty" json:"url,omitempty"`
-	Parameters	[]ParameterOrReference	`yaml:"url,omitempty" json:"url,omitempty"`
+	// A definition of a GET operation on this path.
+	Get *Operation `yaml:"get,omitempty" json:"get,omitempty"`
+	// A definition of a PUT operation on this path.
+	Put *Operation `yaml:"put,omitempty" json:"put,omitempty"`
+	// A definition of a POST operation on this path.
+	Post *Operation `yaml:"post,omitempty" json:"post,omitempty"`
+	// A definition of a DELETE operation on this path.
+	Delete *Operation `yaml:"delete,omitempty" json:"delete,omitempty"`
+	// A definition of a OPTIONS operation on this path.
+	Options *Operation `yaml:"options,omitempty" json:"options,omitempty"`
+	// A definition of a HEAD operation on this path.
+	Head *Operation `yaml:"head,omitempty" json:"head,omitempty"`
+	// A definition of a PATCH operation on this path.
+	Patch *Operation `yaml:"patch,omitempty" json:"patch,omitempty"`
+	// A definition of a TRACE operation on this path.
+	Trace *Operation `yaml:"trace,omitempty" json:"trace,omitempty"`
+	// An alternative server array to service all operations in this path.
+	Servers []Server `yaml:"servers,omitempty" json:"servers,omitempty"`
+	// A list of parameters that are applicable for all the operations described under this path. These parameters can be overridden at the operation level, but cannot be removed there. The list MUST NOT include duplicated parameters. A unique parameter is defined by a combination of a name and location.
+	Parameters []ParameterOrReference `yaml:"parameters,omitempty" json:"parameters,omitempty"`
 }
 
 func (p PathItem) isPathItemOrReference() {}
@@ -181,17 +230,224 @@ type XML struct {
 	Wrapped	bool	`yaml:"wrapped,omitempty" json:"wrapped,omitempty"`
 }
 
+// SchemaType is the value of the type keyword of a schema. A single type is marshalled as a string and several types, for example ["string", "null"] for a nullable string, as an array.
+type SchemaType []string
+
+// MarshalYAML implements yaml.Marshaler.
+func (t SchemaType) MarshalYAML() (interface{}, error) {
+	if len(t) == 1 {
+		return t[0], nil
+	}
+	return []string(t), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t SchemaType) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return marshalJSON(t[0])
+	}
+	return marshalJSON([]string(t))
+}
+
+// SchemaOrBool holds a subschema or, when Schema is nil, one of the boolean schemas true (any instance is valid) and false (no instance is valid).
+type SchemaOrBool struct {
+	Schema *Schema
+	Bool   bool
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s SchemaOrBool) MarshalYAML() (interface{}, error) {
+	if s.Schema != nil {
+		return s.Schema, nil
+	}
+	return s.Bool, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s SchemaOrBool) MarshalJSON() ([]byte, error) {
+	if s.Schema != nil {
+		return marshalJSON(s.Schema)
+	}
+	return marshalJSON(s.Bool)
+}
+
 // Schema allows the definition of input and output data types. These types can be objects, but also primitives and arrays. This object is a superset of the JSON Schema Specification Draft 2020-12.
 type Schema struct {
+	// The dialect of the schema. It SHOULD only be used in a root schema and MUST be in the form of a URI.
+	Schema string `yaml:"$schema,omitempty" json:"$schema,omitempty"`
+	// The canonical URI of the schema, against which relative references in the schema are resolved.
+	ID string `yaml:"$id,omitempty" json:"$id,omitempty"`
+	// A reference to another schema. In OpenAPI documents this is usually a reference to a schema of the Components Object, such as #/components/schemas/Pet.
+	Ref string `yaml:"$ref,omitempty" json:"$ref,omitempty"`
+	// A plain name fragment identifying the schema, so that it can be referenced without a JSON pointer.
+	Anchor string `yaml:"$anchor,omitempty" json:"$anchor,omitempty"`
+	// A reference that is resolved dynamically against the $dynamicAnchor keywords in scope at evaluation time.
+	DynamicRef string `yaml:"$dynamicRef,omitempty" json:"$dynamicRef,omitempty"`
+	// A plain name fragment that can be the target of a $dynamicRef.
+	DynamicAnchor string `yaml:"$dynamicAnchor,omitempty" json:"$dynamicAnchor,omitempty"`
+	// A comment for maintainers of the schema. It has no effect on validation and SHOULD NOT be shown to end users.
+	Comment string `yaml:"$comment,omitempty" json:"$comment,omitempty"`
+	// Reusable schemas local to this schema, referenced with #/$defs/name relative to it.
+	Defs map[string]*Schema `yaml:"$defs,omitempty" json:"$defs,omitempty"`
+
+	// A short title for the data described by the schema.
+	Title string `yaml:"title,omitempty" json:"title,omitempty"`
+	// An explanation of the purpose of the data described by the schema. CommonMark syntax MAY be used for rich text representation.
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// The data type of the instance: one or more of "null", "boolean", "object", "array", "number", "integer" and "string".
+	Type SchemaType `yaml:"type,omitempty" json:"type,omitempty"`
+	// The format of the data type, for example "int32" or "date-time".
+	Format string `yaml:"format,omitempty" json:"format,omitempty"`
+	// The values the instance is restricted to.
+	Enum []interface{} `yaml:"enum,omitempty" json:"enum,omitempty"`
+	// The only value the instance may have.
+	Const interface{} `yaml:"const,omitempty" json:"const,omitempty"`
+	// The value assumed when the instance is absent.
+	Default interface{} `yaml:"default,omitempty" json:"default,omitempty"`
+
+	// A numeric instance MUST be a multiple of this strictly positive number.
+	MultipleOf *float64 `yaml:"multipleOf,omitempty" json:"multipleOf,omitempty"`
+	// A numeric instance MUST be less than or equal to this number.
+	Maximum *float64 `yaml:"maximum,omitempty" json:"maximum,omitempty"`
+	// A numeric instance MUST be strictly less than this number.
+	ExclusiveMaximum *float64 `yaml:"exclusiveMaximum,omitempty" json:"exclusiveMaximum,omitempty"`
+	// A numeric instance MUST be greater than or equal to this number.
+	Minimum *float64 `yaml:"minimum,omitempty" json:"minimum,omitempty"`
+	// A numeric instance MUST be strictly greater than this number.
+	ExclusiveMinimum *float64 `yaml:"exclusiveMinimum,omitempty" json:"exclusiveMinimum,omitempty"`
+	// The maximum length of a string instance, in characters.
+	MaxLength *uint64 `yaml:"maxLength,omitempty" json:"maxLength,omitempty"`
+	// The minimum length of a string instance, in characters.
+	MinLength *uint64 `yaml:"minLength,omitempty" json:"minLength,omitempty"`
+	// A regular expression, in the ECMA-262 dialect, that a string instance MUST match.
+	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+	// The encoding used to store non-JSON data in a string instance, for example "base64".
+	ContentEncoding string `yaml:"contentEncoding,omitempty" json:"contentEncoding,omitempty"`
+	// The media type of the contents of a string instance.
+	ContentMediaType string `yaml:"contentMediaType,omitempty" json:"contentMediaType,omitempty"`
+	// The schema of the decoded contents of a string instance.
+	ContentSchema *Schema `yaml:"contentSchema,omitempty" json:"contentSchema,omitempty"`
+
+	// The schemas that the elements at the same position of an array instance must match.
+	PrefixItems []*Schema `yaml:"prefixItems,omitempty" json:"prefixItems,omitempty"`
+	// The schema that every element of an array instance not matched by prefixItems must match.
+	Items *Schema `yaml:"items,omitempty" json:"items,omitempty"`
+	// A schema that at least one element of an array instance must match.
+	Contains *Schema `yaml:"contains,omitempty" json:"contains,omitempty"`
+	// The maximum number of elements of an array instance.
+	MaxItems *uint64 `yaml:"maxItems,omitempty" json:"maxItems,omitempty"`
+	// The minimum number of elements of an array instance.
+	MinItems *uint64 `yaml:"minItems,omitempty" json:"minItems,omitempty"`
+	// Declares that the elements of an array instance MUST be unique.
+	UniqueItems bool `yaml:"uniqueItems,omitempty" json:"uniqueItems,omitempty"`
+	// The maximum number of elements of an array instance that may match contains.
+	MaxContains *uint64 `yaml:"maxContains,omitempty" json:"maxContains,omitempty"`
+	// The minimum number of elements of an array instance that must match contains.
+	MinContains *uint64 `yaml:"minContains,omitempty" json:"minContains,omitempty"`
+	// The schema applied to the elements of an array instance that were not evaluated by any other keyword.
+	UnevaluatedItems *SchemaOrBool `yaml:"unevaluatedItems,omitempty" json:"unevaluatedItems,omitempty"`
+
+	// The schemas of the named properties of an object instance, in declaration order.
+	Properties orderedMap[*Schema] `yaml:"properties,omitempty" json:"properties,omitempty"`
+	// The schemas of the properties of an object instance whose names match the regular expression keys.
+	PatternProperties map[string]*Schema `yaml:"patternProperties,omitempty" json:"patternProperties,omitempty"`
+	// The schema of the properties of an object instance not matched by properties or patternProperties.
+	AdditionalProperties *SchemaOrBool `yaml:"additionalProperties,omitempty" json:"additionalProperties,omitempty"`
+	// The schema applied to the properties of an object instance that were not evaluated by any other keyword.
+	UnevaluatedProperties *SchemaOrBool `yaml:"unevaluatedProperties,omitempty" json:"unevaluatedProperties,omitempty"`
+	// The schema that every property name of an object instance must match.
+	PropertyNames *Schema `yaml:"propertyNames,omitempty" json:"propertyNames,omitempty"`
+	// The names of the properties an object instance MUST have.
+	Required []string `yaml:"required,omitempty" json:"required,omitempty"`
+	// The maximum number of properties of an object instance.
+	MaxProperties *uint64 `yaml:"maxProperties,omitempty" json:"maxProperties,omitempty"`
+	// The minimum number of properties of an object instance.
+	MinProperties *uint64 `yaml:"minProperties,omitempty" json:"minProperties,omitempty"`
+	// Maps a property name to the properties an object instance MUST have when it has that property.
+	DependentRequired map[string][]string `yaml:"dependentRequired,omitempty" json:"dependentRequired,omitempty"`
+	// Maps a property name to the schema an object instance MUST match when it has that property.
+	DependentSchemas map[string]*Schema `yaml:"dependentSchemas,omitempty" json:"dependentSchemas,omitempty"`
+
+	// The instance MUST match all of these schemas.
+	AllOf []*Schema `yaml:"allOf,omitempty" json:"allOf,omitempty"`
+	// The instance MUST match at least one of these schemas.
+	AnyOf []*Schema `yaml:"anyOf,omitempty" json:"anyOf,omitempty"`
+	// The instance MUST match exactly one of these schemas.
+	OneOf []*Schema `yaml:"oneOf,omitempty" json:"oneOf,omitempty"`
+	// The instance MUST NOT match this schema.
+	Not *Schema `yaml:"not,omitempty" json:"not,omitempty"`
+	// When the instance matches this schema it MUST also match then, otherwise it MUST match else.
+	If *Schema `yaml:"if,omitempty" json:"if,omitempty"`
+	// The schema an instance matching if MUST match.
+	Then *Schema `yaml:"then,omitempty" json:"then,omitempty"`
+	// The schema an instance not matching if MUST match.
+	Else *Schema `yaml:"else,omitempty" json:"else,omitempty"`
+
+	// Declares that the value is managed by the owning authority and that attempts to modify it MAY be ignored or rejected.
+	ReadOnly bool `yaml:"readOnly,omitempty" json:"readOnly,omitempty"`
+	// Declares that the value is never returned by the owning authority, for example a password.
+	WriteOnly bool `yaml:"writeOnly,omitempty" json:"writeOnly,omitempty"`
+	// Declares that applications SHOULD refrain from using the described value.
+	Deprecated bool `yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
+	// Sample values that are valid against the schema.
+	Examples []interface{} `yaml:"examples,omitempty" json:"examples,omitempty"`
+
 	// Adds support for polymorphism. The discriminator is an object name that is used to differentiate between other schemas which may satisfy the payload description. See Composition and Inheritance for more details.
-	Discriminator	Discriminator	`yaml:"discriminator,omitempty" json:"discriminator,omitempty"`
+	Discriminator *Discriminator `yaml:"discriminator,omitempty" json:"discriminator,omitempty"`
 	// This MAY be used only on properties schemas. It has no effect on root schemas. Adds additional metadata to describe the XML representation of this property.
-	XML	XML	`yaml:"xml,omitempty" json:"xml,omitempty"`
+	XML *XML `yaml:"xml,omitempty" json:"xml,omitempty"`
 	// Additional external documentation for this schema.
-	ExternalDocs	ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
+	ExternalDocs *ExternalDocumentation `yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
+	// A free-form property to include an example of an instance for this schema. To represent examples that cannot be naturally represented in JSON or YAML, a string value can be used to contain the example with escaping where necessary. Deprecated in favor of the JSON Schema examples keyword.
+	Example interface{} `yaml:"example,omitempty" json:"example,omitempty"`
+
+	// Specification extensions, whose names MUST begin with x-. They are marshalled after the other fields.
+	Extensions orderedMap[interface{}] `yaml:"-" json:"-"`
+}
+
+// MarshalYAML implements yaml.Marshaler, adding the extensions to the fields of the schema.
+func (s Schema) MarshalYAML() (interface{}, error) {
+	type schema Schema
+	return marshalYAMLExtensions(schema(s), s.Extensions)
+}
+
+// MarshalJSON implements json.Marshaler, adding the extensions to the fields of the schema.
+func (s Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	return marshalJSONExtensions(schema(s), s.Extensions)
+}
+
+// marshalYAMLExtensions returns the YAML mapping of the struct followed by the specification extensions.
+func marshalYAMLExtensions(v interface{}, extensions orderedMap[interface{}]) (interface{}, error) {
+	if len(extensions) == 0 {
+		return v, nil
+	}
+	node := &yaml.Node{}
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+	ext, err := extensions.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+	node.Content = append(node.Content, ext.(*yaml.Node).Content...)
+	return node, nil
+}
 
-	// A free-form property to include an example of an instance for this schema. To represent examples that cannot be naturally represented in JSON or YAML, a string value can be used to contain the example with escaping where necessary.
-	// Example	Any	`yaml:"example,omitempty" json:"example,omitempty"`
+// marshalJSONExtensions returns the JSON object of the struct followed by the specification extensions.
+func marshalJSONExtensions(v interface{}, extensions orderedMap[interface{}]) ([]byte, error) {
+	b, err := marshalJSON(v)
+	if err != nil || len(extensions) == 0 {
+		return b, err
+	}
+	ext, err := extensions.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if string(b) == "{}" {
+		return ext, nil
+	}
+	return append(append(b[:len(b)-1], ','), ext[1:]...), nil
 }
 
 // HeaderOrReference ...
@@ -213,6 +469,14 @@ type Parameter struct {
 	Deprecated	bool	`yaml:"deprecated,omitempty" json:"deprecated,omitempty"`
 	// Sets the ability to pass empty-valued parameters. This is valid only for query parameters and allows sending a parameter with an empty value. Default value is false. If style is used, and if behavior is n/a (cannot be serialized), the value of allowEmptyValue SHALL be ignored. Use of this property is NOT RECOMMENDED, as it is likely to be removed in a later revision.
 	AllowEmptyValue	bool	`yaml:"allowEmptyValue,omitempty" json:"allowEmptyValue,omitempty"`
+	// Describes how the parameter value will be serialized depending on the type of the parameter value. Default values (based on value of in): for query - form; for path - simple; for header - simple; for cookie - form.
+	Style	string	`yaml:"style,omitempty" json:"style,omitempty"`
+	// When this is true, parameter values of type array or object generate separate parameters for each value of the array or key-value pair of the map. For other types of parameters this property has no effect. When style is form, the default value is true. For all other styles, the default value is false.
+	Explode	bool	`yaml:"explode,omitempty" json:"explode,omitempty"`
+	// The schema defining the type used for the parameter.
+	Schema *Schema `yaml:"schema,omitempty" json:"schema,omitempty"`
+	// Example of the parameter's potential value. The example SHOULD match the specified schema and encoding properties if present.
+	Example	interface{}	`yaml:"example,omitempty" json:"example,omitempty"`
 }
 
 func (p Parameter) isParameterOrReference() {}
@@ -250,6 +514,12 @@ func (r Reference) isLinkOrReference() {}
 
 func (r Reference) isResponseOrReference() {}
 
+func (r Reference) isParameterOrReference() {}
+
+func (r Reference) isRequestBodyOrReference() {}
+
+func (r Reference) isSecuritySchemeOrReference() {}
+
 // Example ...
 type Example struct {
 	// Short description for the example.
@@ -283,10 +553,10 @@ type Encoding struct {
 // MediaType Object provides schema and examples for the media type identified by its key.
 type MediaType struct {
 	// The schema defining the content of the request, response, or parameter.
-	Schema	Schema	`yaml:"schema,omitempty" json:"schema,omitempty"`
+	Schema	*Schema	`yaml:"schema,omitempty" json:"schema,omitempty"`
 
 	// Example of the media type. The example object SHOULD be in the correct format as specified by the media type. The example field is mutually exclusive of the examples field. Furthermore, if referencing a schema which contains an example, the example value SHALL override the example provided by the schema.
-	// Example	Any	`yaml:"example,omitempty" json:"example,omitempty"`
+	Example	interface{}	`yaml:"example,omitempty" json:"example,omitempty"`
 
 	// Examples of the media type. Each example object SHOULD match the media type and specified schema if present. The examples field is mutually exclusive of the example field. Furthermore, if referencing a schema which contains an example, the examples value SHALL override the example provided by the schema.
 	Examples	map[string]ExampleOrReference	`yaml:"examples,omitempty" json:"examples,omitempty"`
@@ -300,10 +570,14 @@ type Link struct {
 	OperationRef	string	`yaml:"operationRef,omitempty" json:"operationRef,omitempty"`
 	// The name of an existing, resolvable OAS operation, as defined with a unique operationId. This field is mutually exclusive of the operationRef field.
 	OperationID	string	`yaml:"operationId,omitempty" json:"operationId,omitempty"`
-	// parameters	map[]	`yaml:"parameters,omitempty" json:"parameters,omitempty"`
-	// requestBody		`yaml:"requestBody,omitempty" json:"requestBody,omitempty"`
-	// description	`yaml:"description,omitempty" json:"description,omitempty"`
-	// server	`yaml:"server,omitempty" json:"server,omitempty"`
+	// A map representing parameters to pass to an operation as specified with operationId or identified via operationRef. The key is the parameter name to be used, whereas the value can be a constant or an expression to be evaluated and passed to the linked operation.
+	Parameters	map[string]interface{}	`yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	// A literal value or {expression} to use as a request body when calling the target operation.
+	RequestBody	interface{}	`yaml:"requestBody,omitempty" json:"requestBody,omitempty"`
+	// A description of the link. CommonMark syntax MAY be used for rich text representation.
+	Description	string	`yaml:"description,omitempty" json:"description,omitempty"`
+	// A server object to be used by the target operation.
+	Server	*Server	`yaml:"server,omitempty" json:"server,omitempty"`
 }
 
 func (l Link) isLinkOrReference() {}
@@ -317,15 +591,17 @@ type Response struct {
 	// A map containing descriptions of potential response payloads. The key is a media type or media type range and the value describes it. For responses that match multiple keys, only the most specific key is applicable. e.g. text/plain overrides text/*
 	Content	map[string]MediaType	`yaml:"content,omitempty" json:"content,omitempty"`
 	// A map of operations links that can be followed from the response. The key of the map is a short name for the link, following the naming constraints of the names for Component Objects.
-	Link	map[string]LinkOrReference	`yaml:"link,omitempty" json:"link,omitempty"`
+	Links	map[string]LinkOrReference	`yaml:"links,omitempty" json:"links,omitempty"`
 }
 
+func (r Response) isResponseOrReference() {}
+
 // Components holds a set of reusable objects for different aspects of the OAS. All objects defined within the components object will have no effect on the API unless they are explicitly referenced from properties outside the components object.
 type Components struct {
 	// An object to hold reusable Schema Objects.
-	Schemas	map[string]Schema	`yaml:"schemas,omitempty" json:"schemas,omitempty"`
+	Schemas	orderedMap[*Schema]	`yaml:"schemas,omitempty" json:"schemas,omitempty"`
 	// An object to hold reusable Response Objects.
-	responses	map[string]ResponseOrReference	`yaml:"xml,omitempty" json:"xml,omitempty"`
+	Responses	map[string]ResponseOrReference	`yaml:"responses,omitempty" json:"responses,omitempty"`
 
 
 	// parameters	`yaml:"xml,omitempty" json:"xml,omitempty"`
@@ -340,7 +616,8 @@ type Components struct {
 	// headers	`yaml:"xml,omitempty" json:"xml,omitempty"`
 
 
-	// securitySchemes	`yaml:"xml,omitempty" json:"xml,omitempty"`
+	// An object to hold reusable Security Scheme Objects.
+	SecuritySchemes	map[string]SecuritySchemeOrReference	`yaml:"securitySchemes,omitempty" json:"securitySchemes,omitempty"`
 
 
 	// links	`yaml:"xml,omitempty" json:"xml,omitempty"`
@@ -353,7 +630,59 @@ type Components struct {
 }
 
 // SecurityRequirement lists the required security schemes to execute this operation. The name used for each property MUST correspond to a security scheme declared in the Security Schemes under the Components Object.
-type SecurityRequirement struct {}
+// Each name maps to the list of scope names required for the execution for oauth2 and openIdConnect schemes, and to an empty list for other schemes.
+type SecurityRequirement map[string][]string
+
+// SecuritySchemeOrReference ...
+type SecuritySchemeOrReference interface {
+	isSecuritySchemeOrReference()
+}
+
+// SecurityScheme defines a security scheme that can be used by the operations.
+type SecurityScheme struct {
+	// REQUIRED. The type of the security scheme. Valid values are "apiKey", "http", "mutualTLS", "oauth2", "openIdConnect".
+	Type	string	`yaml:"type,omitempty" json:"type,omitempty"`
+	// A description for security scheme. CommonMark syntax MAY be used for rich text representation.
+	Description	string	`yaml:"description,omitempty" json:"description,omitempty"`
+	// REQUIRED for apiKey. The name of the header, query or cookie parameter to be used.
+	Name	string	`yaml:"name,omitempty" json:"name,omitempty"`
+	// REQUIRED for apiKey. The location of the API key. Valid values are "query", "header" or "cookie".
+	In	string	`yaml:"in,omitempty" json:"in,omitempty"`
+	// REQUIRED for http. The name of the HTTP Authorization scheme to be used in the Authorization header as defined in RFC7235, such as basic or bearer.
+	Scheme	string	`yaml:"scheme,omitempty" json:"scheme,omitempty"`
+	// A hint to the client to identify how the bearer token is formatted. Bearer tokens are usually generated by an authorization server, so this information is primarily for documentation purposes.
+	BearerFormat	string	`yaml:"bearerFormat,omitempty" json:"bearerFormat,omitempty"`
+	// REQUIRED for oauth2. An object containing configuration information for the flow types supported.
+	Flows	*OAuthFlows	`yaml:"flows,omitempty" json:"flows,omitempty"`
+	// REQUIRED for openIdConnect. OpenId Connect URL to discover OAuth2 configuration values. This MUST be in the form of a URL.
+	OpenIDConnectURL	string	`yaml:"openIdConnectUrl,omitempty" json:"openIdConnectUrl,omitempty"`
+}
+
+func (s SecurityScheme) isSecuritySchemeOrReference() {}
+
+// OAuthFlows allows configuration of the supported OAuth Flows.
+type OAuthFlows struct {
+	// Configuration for the OAuth Implicit flow.
+	Implicit	*OAuthFlow	`yaml:"implicit,omitempty" json:"implicit,omitempty"`
+	// Configuration for the OAuth Resource Owner Password flow.
+	Password	*OAuthFlow	`yaml:"password,omitempty" json:"password,omitempty"`
+	// Configuration for the OAuth Client Credentials flow.
+	ClientCredentials	*OAuthFlow	`yaml:"clientCredentials,omitempty" json:"clientCredentials,omitempty"`
+	// Configuration for the OAuth Authorization Code flow.
+	AuthorizationCode	*OAuthFlow	`yaml:"authorizationCode,omitempty" json:"authorizationCode,omitempty"`
+}
+
+// OAuthFlow holds configuration details for a supported OAuth Flow.
+type OAuthFlow struct {
+	// REQUIRED for implicit and authorizationCode. The authorization URL to be used for this flow. This MUST be in the form of a URL.
+	AuthorizationURL	string	`yaml:"authorizationUrl,omitempty" json:"authorizationUrl,omitempty"`
+	// REQUIRED for password, clientCredentials and authorizationCode. The token URL to be used for this flow. This MUST be in the form of a URL.
+	TokenURL	string	`yaml:"tokenUrl,omitempty" json:"tokenUrl,omitempty"`
+	// The URL to be used for obtaining refresh tokens. This MUST be in the form of a URL.
+	RefreshURL	string	`yaml:"refreshUrl,omitempty" json:"refreshUrl,omitempty"`
+	// REQUIRED. The available scopes for the OAuth2 security scheme. A map between the scope name and a short description for it. The map MAY be empty.
+	Scopes	map[string]string	`yaml:"scopes" json:"scopes"`
+}
 
 // Tag adds metadata to a single tag that is used by the Operation Object. It is not mandatory to have a Tag Object per tag defined in the Operation Object instances.
 type Tag struct {
@@ -362,7 +691,7 @@ type Tag struct {
 	// A description for the tag. CommonMark syntax MAY be used for rich text representation.
 	Description	string	`yaml:"description,omitempty" json:"description,omitempty"`
 	// Additional external documentation for this tag.
-	ExternalDocs	ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
+	ExternalDocs	*ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
 }
 
 // OpenAPI is the root object of the OpenAPI document.
@@ -370,7 +699,7 @@ type OpenAPI struct {
 	// REQUIRED. This string MUST be the version number of the OpenAPI Specification that the OpenAPI document uses. The openapi field SHOULD be used by tooling to interpret the OpenAPI document. This is not related to the API info.version string.
 	OpenAPI string `yaml:"openapi,omitempty" json:"openapi,omitempty"`
 	// REQUIRED. Provides metadata about the API. The metadata MAY be used by tooling as required.
-	Info Info `yaml:"info,omitempty" json:"info,omitempty"`
+	Info Info `yaml:"info" json:"info"`
 	// The default value for the $schema keyword within Schema Objects contained within this OAS document. This MUST be in the form of a URI.
 	JSONSchemaDialect string `yaml:"jsonSchemaDialect,omitempty" json:"jsonSchemaDialect,omitempty"`
 	// An array of Server Objects, which provide connectivity information to a target server. If the servers property is not provided, or is an empty array, the default value would be a Server Object with a url value of /.
@@ -380,25 +709,126 @@ type OpenAPI struct {
 	// The incoming webhooks that MAY be received as part of this API and that the API consumer MAY choose to implement. Closely related to the callbacks feature, this section describes requests initiated other than by an API call, for example by an out of band registration. The key name is a unique string to refer to each webhook, while the (optionally referenced) Path Item Object describes a request that may be initiated by the API provider and the expected responses. An example is available.
 	Webhooks	map[string]PathItemOrReference	`yaml:"webhooks,omitempty" json:"webhooks,omitempty"`
 	// An element to hold various schemas for the document.
-	Components	Components	`yaml:"components,omitempty" json:"components,omitempty"`
+	Components	*Components	`yaml:"components,omitempty" json:"components,omitempty"`
 	// A declaration of which security mechanisms can be used across the API. The list of values includes alternative security requirement objects that can be used. Only one of the security requirement objects need to be satisfied to authorize a request. Individual operations can override this definition. To make security optional, an empty security requirement ({}) can be included in the array.
 	Security	[]SecurityRequirement	`yaml:"security,omitempty" json:"security,omitempty"`
 	// A list of tags used by the document with additional metadata. The order of the tags can be used to reflect on their order by the parsing tools. Not all tags that are used by the Operation Object must be declared. The tags that are not declared MAY be organized randomly or based on the tools’ logic. Each tag name in the list MUST be unique.
 	Tags	[]Tag	`yaml:"tags,omitempty" json:"tags,omitempty"`
 	// Additional external documentation.
-	ExternalDocs	ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
+	ExternalDocs	*ExternalDocumentation	`yaml:"externalDocs,omitempty" json:"externalDocs,omitempty"`
 }
 
 func main() {
-	protogen.Options{}.Run(func(gen *protogen.Plugin) error {
-		d := OpenAPI{}
-		d.OpenAPI = "3.1.0"
-		bytes, err := yaml.Marshal(d)
+	conf := newConfig()
+	protogen.Options{ParamFunc: conf.paramFunc()}.Run(func(gen *protogen.Plugin) error {
+		return generate(gen, conf)
+	})
+}
+
+// generate writes the OpenAPI documents describing the files of the request.
+func generate(gen *protogen.Plugin, conf *Config) error {
+	if err := conf.load(); err != nil {
+		return err
+	}
+	docs := documentFiles(gen, conf)
+	// Every document is generated once per format, so the same problem is only reported once.
+	warned := map[string]bool{}
+	warn := func(warnings []string) {
+		for _, w := range warnings {
+			if !warned[w] {
+				warned[w] = true
+				fmt.Fprintf(os.Stderr, "protoc-gen-openapi: warning: %s\n", w)
+			}
+		}
+	}
+	names, warnings := newNames(gen, conf.Naming)
+	warn(warnings)
+	owners := map[string]string{}
+	for _, doc := range docs {
+		for _, f := range doc.files {
+			owners[f.Desc.Path()] = doc.name
+		}
+	}
+	for _, doc := range docs {
+		for _, format := range conf.formats() {
+			g := newGenerator(gen, conf, doc, owners, names, format)
+			d, err := g.document()
+			if err != nil {
+				return err
+			}
+			warn(g.warnings)
+			if err := writeDocument(gen, doc.name+"."+format, d); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// documentFile is an output document and the proto files whose services and messages it describes.
+type documentFile struct {
+	// The path of the document relative to the output directory, without extension.
+	name  string
+	files []*protogen.File
+}
+
+// documentFiles groups the files to generate into output documents according to the output mode. Imported files are not described by any document, their messages are only added to the documents that reference them.
+func documentFiles(gen *protogen.Plugin, conf *Config) []*documentFile {
+	switch conf.OutputMode {
+	case "package":
+		var docs []*documentFile
+		byPackage := map[protoreflect.FullName]*documentFile{}
+		for _, f := range gen.Files {
+			if !f.Generate {
+				continue
+			}
+			doc, ok := byPackage[f.Desc.Package()]
+			if !ok {
+				doc = &documentFile{name: path.Join(strings.ReplaceAll(string(f.Desc.Package()), ".", "/"), "openapi")}
+				byPackage[f.Desc.Package()] = doc
+				docs = append(docs, doc)
+			}
+			doc.files = append(doc.files, f)
+		}
+		return docs
+	case "file":
+		var docs []*documentFile
+		for _, f := range gen.Files {
+			if f.Generate {
+				docs = append(docs, &documentFile{name: f.GeneratedFilenamePrefix + ".openapi", files: []*protogen.File{f}})
+			}
+		}
+		return docs
+	}
+	doc := &documentFile{name: "openapi"}
+	for _, f := range gen.Files {
+		if f.Generate {
+			doc.files = append(doc.files, f)
+		}
+	}
+	return []*documentFile{doc}
+}
+
+// writeDocument writes the document to the file, as YAML or JSON depending on its extension.
+func writeDocument(gen *protogen.Plugin, filename string, d *OpenAPI) error {
+	var content []byte
+	if strings.HasSuffix(filename, ".json") {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(d); err != nil {
+			return fmt.Errorf("failed to marshal json: %s", err.Error())
+		}
+		content = buf.Bytes()
+	} else {
+		var err error
+		content, err = yaml.Marshal(d)
 		if err != nil {
 			return fmt.Errorf("failed to marshal yaml: %s", err.Error())
 		}
-		outputFile := gen.NewGeneratedFile("openapi.yaml", "")
-		outputFile.Write(bytes)
-		return nil
-	})
+	}
+	outputFile := gen.NewGeneratedFile(filename, "")
+	outputFile.Write(content)
+	return nil
 }
@@ -1,12 +1,430 @@
 package main
 
 import (
-    "os"
-    "testing"
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"gopkg.in/yaml.v3"
 )
 
 func TestMain(m *testing.M) {
-    os.Exit(m.Run())
+	os.Exit(m.Run())
 }
 
 func TestFoo(t *testing.T) {}
+
+// newRequest compiles the proto sources, keyed by file name, into a request to generate all of them.
+// Imports that are not part of sources are resolved from the standard imports and the global registry.
+func newRequest(t *testing.T, sources map[string]string, parameter string) *pluginpb.CodeGeneratorRequest {
+	t.Helper()
+	var names []string
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(protocompile.CompositeResolver{
+			&protocompile.SourceResolver{Accessor: protocompile.SourceAccessorFromMap(sources)},
+			protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+				fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+				return protocompile.SearchResult{Desc: fd}, err
+			}),
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	files, err := compiler.Compile(context.Background(), names...)
+	if err != nil {
+		t.Fatalf("failed to compile protos: %v", err)
+	}
+	req := &pluginpb.CodeGeneratorRequest{FileToGenerate: names, Parameter: proto.String(parameter)}
+	seen := map[string]bool{}
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		for i := 0; i < fd.Imports().Len(); i++ {
+			add(fd.Imports().Get(i).FileDescriptor)
+		}
+		fdp := protodesc.ToFileDescriptorProto(fd)
+		if fdp.GetOptions().GetGoPackage() == "" {
+			if fdp.Options == nil {
+				fdp.Options = &descriptorpb.FileOptions{}
+			}
+			fdp.Options.GoPackage = proto.String("example.com/" + fd.Path())
+		}
+		req.ProtoFile = append(req.ProtoFile, fdp)
+	}
+	for _, fd := range files {
+		add(fd)
+	}
+	// Round-trip through the wire format, as protoc does, so that options are parsed into their generated types.
+	b, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+	req = &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(b, req); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+	return req
+}
+
+// runPlugin runs the plugin on the request and returns the content of the generated files, keyed by name.
+func runPlugin(t *testing.T, req *pluginpb.CodeGeneratorRequest) map[string]string {
+	t.Helper()
+	conf := newConfig()
+	gen, err := protogen.Options{ParamFunc: conf.paramFunc()}.New(req)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	if err := generate(gen, conf); err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	resp := gen.Response()
+	if resp.Error != nil {
+		t.Fatalf("plugin returned error: %s", resp.GetError())
+	}
+	out := map[string]string{}
+	for _, f := range resp.File {
+		out[f.GetName()] = f.GetContent()
+	}
+	return out
+}
+
+// generateYAML runs the plugin on the proto sources and returns the decoded openapi.yaml document.
+func generateYAML(t *testing.T, sources map[string]string, parameter string) map[string]interface{} {
+	t.Helper()
+	out := runPlugin(t, newRequest(t, sources, parameter))
+	content, ok := out["openapi.yaml"]
+	if !ok {
+		t.Fatalf("openapi.yaml was not generated, got %v", out)
+	}
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+	return doc
+}
+
+// lookup returns the value found by following the keys and indexes of the path through a decoded document.
+func lookup(v interface{}, path ...interface{}) (interface{}, bool) {
+	for _, p := range path {
+		switch key := p.(type) {
+		case string:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if v, ok = m[key]; !ok {
+				return nil, false
+			}
+		case int:
+			a, ok := v.([]interface{})
+			if !ok || key >= len(a) {
+				return nil, false
+			}
+			v = a[key]
+		}
+	}
+	return v, true
+}
+
+// mustLookup returns the value at the path of the document, failing the test if there is none.
+func mustLookup(t *testing.T, doc interface{}, path ...interface{}) interface{} {
+	t.Helper()
+	v, ok := lookup(doc, path...)
+	if !ok {
+		t.Fatalf("%v: not found", path)
+	}
+	return v
+}
+
+// assertPath fails the test unless the value at the path of the document equals want.
+func assertPath(t *testing.T, doc interface{}, want interface{}, path ...interface{}) {
+	t.Helper()
+	got, ok := lookup(doc, path...)
+	if !ok {
+		t.Errorf("%v: not found", path)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("%v: got %#v, want %#v", path, got, want)
+	}
+}
+
+// assertNoPath fails the test if the document has a value at the path.
+func assertNoPath(t *testing.T, doc interface{}, path ...interface{}) {
+	t.Helper()
+	if got, ok := lookup(doc, path...); ok {
+		t.Errorf("%v: got %#v, want nothing", path, got)
+	}
+}
+
+func TestSchemaMarshal(t *testing.T) {
+	max := 10.0
+	s := &Schema{
+		Type:                 SchemaType{"object"},
+		AdditionalProperties: &SchemaOrBool{Bool: false},
+		Required:             []string{"b"},
+	}
+	s.Properties.Set("b", &Schema{Type: SchemaType{"string", "null"}, Pattern: "^<.*>$"})
+	s.Properties.Set("a", &Schema{Type: SchemaType{"integer"}, Maximum: &max, Const: 0})
+	s.Properties.Set("c", &Schema{Items: &Schema{Ref: "#/$defs/c"}, Defs: map[string]*Schema{"c": {Type: SchemaType{"boolean"}}}})
+
+	y, err := yaml.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantYAML := `type: object
+properties:
+    b:
+        type:
+            - string
+            - "null"
+        pattern: ^<.*>$
+    a:
+        type: integer
+        const: 0
+        maximum: 10
+    c:
+        $defs:
+            c:
+                type: boolean
+        items:
+            $ref: '#/$defs/c'
+additionalProperties: false
+required:
+    - b
+`
+	if string(y) != wantYAML {
+		t.Errorf("yaml:\n%s\nwant:\n%s", y, wantYAML)
+	}
+
+	j, err := marshalJSON(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `{"type":"object","properties":{"b":{"type":["string","null"],"pattern":"^<.*>$"},"a":{"type":"integer","const":0,"maximum":10},"c":{"$defs":{"c":{"type":"boolean"}},"items":{"$ref":"#/$defs/c"}}},"additionalProperties":false,"required":["b"]}`
+	if string(j) != wantJSON {
+		t.Errorf("json:\n%s\nwant:\n%s", j, wantJSON)
+	}
+}
+
+func TestResponsesMarshal(t *testing.T) {
+	r := Responses{
+		Default: Reference{Ref: "#/components/responses/Error"},
+		Codes: map[string]ResponseOrReference{
+			"4XX": Response{Description: "Client Error"},
+			"404": Reference{Ref: "#/components/responses/Error"},
+			"200": Response{Description: "OK", Links: map[string]LinkOrReference{"next": Reference{Ref: "#/components/links/Next"}}},
+		},
+	}
+
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantYAML := `"200":
+    description: OK
+    links:
+        next:
+            $ref: '#/components/links/Next'
+"404":
+    $ref: '#/components/responses/Error'
+4XX:
+    description: Client Error
+default:
+    $ref: '#/components/responses/Error'
+`
+	if string(y) != wantYAML {
+		t.Errorf("yaml:\n%s\nwant:\n%s", y, wantYAML)
+	}
+
+	j, err := marshalJSON(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `{"200":{"description":"OK","links":{"next":{"$ref":"#/components/links/Next"}}},"404":{"$ref":"#/components/responses/Error"},"4XX":{"description":"Client Error"},"default":{"$ref":"#/components/responses/Error"}}`
+	if string(j) != wantJSON {
+		t.Errorf("json:\n%s\nwant:\n%s", j, wantJSON)
+	}
+}
+
+func TestPathItemMarshal(t *testing.T) {
+	var paths Paths
+	paths.Set("/b", &PathItem{Get: &Operation{OperationID: "getB"}})
+	paths.Set("/a", &PathItem{Delete: &Operation{OperationID: "deleteA"}})
+
+	y, err := yaml.Marshal(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantYAML := `/b:
+    get:
+        operationId: getB
+/a:
+    delete:
+        operationId: deleteA
+`
+	if string(y) != wantYAML {
+		t.Errorf("yaml:\n%s\nwant:\n%s", y, wantYAML)
+	}
+
+	j, err := marshalJSON(paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `{"/b":{"get":{"operationId":"getB"}},"/a":{"delete":{"operationId":"deleteA"}}}`
+	if string(j) != wantJSON {
+		t.Errorf("json:\n%s\nwant:\n%s", j, wantJSON)
+	}
+}
+
+func TestOutputFormat(t *testing.T) {
+	sources := map[string]string{"library.proto": libraryProto}
+	out := runPlugin(t, newRequest(t, sources, "output_format=json"))
+	if _, ok := out["openapi.yaml"]; ok || out["openapi.json"] == "" {
+		t.Errorf("output_format=json: got files %v, want only openapi.json", out)
+	}
+
+	out = runPlugin(t, newRequest(t, sources, "output_format=both,title=A & B"))
+	var y, j yaml.Node
+	if err := yaml.Unmarshal([]byte(out["openapi.yaml"]), &y); err != nil {
+		t.Fatal(err)
+	}
+	// JSON is a subset of YAML, so both documents can be decoded and compared as YAML nodes.
+	if err := yaml.Unmarshal([]byte(out["openapi.json"]), &j); err != nil {
+		t.Fatal(err)
+	}
+	assertSameNode(t, "$", &y, &j)
+}
+
+// assertSameNode fails the test unless the nodes have the same structure, key order, tags and values, ignoring their style.
+func assertSameNode(t *testing.T, path string, a, b *yaml.Node) {
+	t.Helper()
+	if a.Kind != b.Kind || a.Tag != b.Tag || a.Value != b.Value || len(a.Content) != len(b.Content) {
+		t.Errorf("%s: got %s %q with %d children and %s %q with %d children", path, a.Tag, a.Value, len(a.Content), b.Tag, b.Value, len(b.Content))
+		return
+	}
+	for i := range a.Content {
+		child := fmt.Sprintf("%s[%d]", path, i)
+		if a.Kind == yaml.MappingNode {
+			child = path + "." + a.Content[i-i%2].Value
+		}
+		assertSameNode(t, child, a.Content[i], b.Content[i])
+	}
+}
+
+var outputModeSources = map[string]string{
+	"shop/v1/order.proto": `
+		syntax = "proto3";
+		package shop.v1;
+		import "common/v1/money.proto";
+		import "google/protobuf/timestamp.proto";
+		import "shop/v1/item.proto";
+		message Order {
+			common.v1.Money total = 1;
+			google.protobuf.Timestamp create_time = 2;
+			Item item = 3;
+		}
+	`,
+	"shop/v1/item.proto": `
+		syntax = "proto3";
+		package shop.v1;
+		message Item {
+			string sku = 1;
+		}
+	`,
+	"common/v1/money.proto": `
+		syntax = "proto3";
+		package common.v1;
+		message Money {
+			string currency_code = 1;
+		}
+	`,
+}
+
+func TestOutputModeFile(t *testing.T) {
+	out := runPlugin(t, newRequest(t, outputModeSources, "output_mode=file,paths=source_relative"))
+	if len(out) != 3 {
+		t.Errorf("got files %v, want one per proto file", out)
+	}
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(out["shop/v1/order.openapi.yaml"]), &doc); err != nil {
+		t.Fatal(err)
+	}
+	order := mustLookup(t, doc, "components", "schemas", "shop.v1.Order", "properties")
+	assertPath(t, order, "../../common/v1/money.openapi.yaml#/components/schemas/common.v1.Money", "total", "$ref")
+	assertPath(t, order, "item.openapi.yaml#/components/schemas/shop.v1.Item", "item", "$ref")
+	// Messages of files without a document of their own are added to the document referencing them.
+	assertPath(t, order, "#/components/schemas/google.protobuf.Timestamp", "createTime", "$ref")
+	mustLookup(t, doc, "components", "schemas", "google.protobuf.Timestamp")
+	assertNoPath(t, doc, "components", "schemas", "shop.v1.Item")
+}
+
+func TestOutputModePackage(t *testing.T) {
+	out := runPlugin(t, newRequest(t, outputModeSources, "output_mode=package,output_format=json"))
+	if len(out) != 2 {
+		t.Errorf("got files %v, want one per proto package", out)
+	}
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(out["shop/v1/openapi.json"]), &doc); err != nil {
+		t.Fatal(err)
+	}
+	order := mustLookup(t, doc, "components", "schemas", "shop.v1.Order", "properties")
+	assertPath(t, order, "../../common/v1/openapi.json#/components/schemas/common.v1.Money", "total", "$ref")
+	assertPath(t, order, "#/components/schemas/shop.v1.Item", "item", "$ref")
+	mustLookup(t, doc, "components", "schemas", "shop.v1.Item")
+}
+
+func TestOutputModeMerged(t *testing.T) {
+	doc := generateYAML(t, outputModeSources, "")
+	assertPath(t, doc, "#/components/schemas/common.v1.Money", "components", "schemas", "shop.v1.Order", "properties", "total", "$ref")
+	mustLookup(t, doc, "components", "schemas", "common.v1.Money")
+}
+
+func TestSchemaExtensions(t *testing.T) {
+	s := &Schema{Type: SchemaType{"string"}}
+	s.Extensions.Set("x-b", []string{"one"})
+	s.Extensions.Set("x-a", true)
+
+	y, err := yaml.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantYAML := "type: string\nx-b:\n    - one\nx-a: true\n"
+	if string(y) != wantYAML {
+		t.Errorf("yaml:\n%s\nwant:\n%s", y, wantYAML)
+	}
+
+	for _, tt := range []struct {
+		schema *Schema
+		want   string
+	}{
+		{s, `{"type":"string","x-b":["one"],"x-a":true}`},
+		{&Schema{Extensions: s.Extensions}, `{"x-b":["one"],"x-a":true}`},
+		{&Schema{Type: SchemaType{"string"}}, `{"type":"string"}`},
+	} {
+		j, err := marshalJSON(tt.schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(j) != tt.want {
+			t.Errorf("json:\n%s\nwant:\n%s", j, tt.want)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// namingStrategies lists the naming strategies from the shortest names to the fully-qualified ones that cannot collide. Colliding names are resolved by moving on to the next strategy.
+var namingStrategies = []string{"short", "nested", "package_prefixed", "fqn"}
+
+// names holds the names given to the types and services of the request by the naming strategy.
+type names struct {
+	// schemas maps the full name of every message and enum to the key of its component schema.
+	schemas map[protoreflect.FullName]string
+	// services maps the full name of every service to its name, which names its tag and prefixes its operation IDs.
+	services map[protoreflect.FullName]string
+}
+
+// newNames names the types and services that the documents of the request can describe, so that documents referencing each other agree on schema names: the types and services of the files to generate and the types they reach. Unreachable types of imported files, such as those of descriptor.proto, take no part, so that they cannot collide with the types of the API. It returns a warning for every set of names that collided.
+func newNames(gen *protogen.Plugin, strategy string) (*names, []string) {
+	var types, services []protoreflect.Descriptor
+	routed := false
+	seen := map[protoreflect.FullName]bool{}
+	addType := func(desc protoreflect.Descriptor) bool {
+		if seen[desc.FullName()] {
+			return false
+		}
+		seen[desc.FullName()] = true
+		types = append(types, desc)
+		return true
+	}
+	var addMessage func(*protogen.Message)
+	addFields := func(m *protogen.Message) {
+		for _, f := range m.Fields {
+			if f.Message != nil {
+				addMessage(f.Message)
+			}
+			if f.Enum != nil {
+				addType(f.Enum.Desc)
+			}
+		}
+	}
+	addMessage = func(m *protogen.Message) {
+		if m.Desc.IsMapEntry() {
+			addFields(m)
+			return
+		}
+		if addType(m.Desc) {
+			addFields(m)
+		}
+	}
+	var addMessages func([]*protogen.Message)
+	addMessages = func(messages []*protogen.Message) {
+		for _, m := range messages {
+			addMessage(m)
+			for _, e := range m.Enums {
+				addType(e.Desc)
+			}
+			addMessages(m.Messages)
+		}
+	}
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+		for _, e := range f.Enums {
+			addType(e.Desc)
+		}
+		addMessages(f.Messages)
+		for _, s := range f.Services {
+			services = append(services, s.Desc)
+			for _, m := range s.Methods {
+				addMessage(m.Input)
+				addMessage(m.Output)
+				routed = routed || proto.HasExtension(m.Desc.Options(), annotations.E_Http)
+			}
+		}
+	}
+	// Documents with operations describe their errors with google.rpc.Status and google.protobuf.Any, whether or not the files import them.
+	if routed {
+		addType((&statuspb.Status{}).ProtoReflect().Descriptor())
+		addType((&anypb.Any{}).ProtoReflect().Descriptor())
+	}
+	schemas, warnings := resolveNames(types, strategy, "schema")
+	serviceNames, serviceWarnings := resolveNames(services, strategy, "service")
+	return &names{schemas: schemas, services: serviceNames}, append(warnings, serviceWarnings...)
+}
+
+// schema returns the key of the component schema of the message or enum.
+func (n *names) schema(desc protoreflect.Descriptor) string {
+	if name, ok := n.schemas[desc.FullName()]; ok {
+		return name
+	}
+	return string(desc.FullName())
+}
+
+// taken reports whether a message or enum has the component schema name.
+func (n *names) taken(name string) bool {
+	for _, s := range n.schemas {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// service returns the name of the service, which names its tag and prefixes its operation IDs.
+func (n *names) service(desc protoreflect.Descriptor) string {
+	if name, ok := n.services[desc.FullName()]; ok {
+		return name
+	}
+	return string(desc.FullName())
+}
+
+// operationIDName returns the name with the dots of fully-qualified names replaced by underscores, as operation IDs become method names of generated clients.
+func operationIDName(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// resolveNames names the descriptors with the strategy. Descriptors whose names collide are named with the next strategy in turn, until all names are unique, which makes the result independent of the order of the descriptors.
+func resolveNames(descs []protoreflect.Descriptor, strategy, kind string) (map[protoreflect.FullName]string, []string) {
+	level := map[protoreflect.FullName]int{}
+	start := 0
+	for i, s := range namingStrategies {
+		if s == strategy {
+			start = i
+		}
+	}
+	for _, d := range descs {
+		level[d.FullName()] = start
+	}
+	for {
+		byName := map[string][]protoreflect.Descriptor{}
+		for _, d := range descs {
+			name := strategyName(d, namingStrategies[level[d.FullName()]])
+			byName[name] = append(byName[name], d)
+		}
+		collided := false
+		for _, group := range byName {
+			if len(group) < 2 {
+				continue
+			}
+			for _, d := range group {
+				if level[d.FullName()] < len(namingStrategies)-1 {
+					level[d.FullName()]++
+					collided = true
+				}
+			}
+		}
+		if !collided {
+			break
+		}
+	}
+
+	resolved := map[protoreflect.FullName]string{}
+	wanted := map[string][]protoreflect.FullName{}
+	for _, d := range descs {
+		resolved[d.FullName()] = strategyName(d, namingStrategies[level[d.FullName()]])
+		name := strategyName(d, strategy)
+		wanted[name] = append(wanted[name], d.FullName())
+	}
+	var warnings []string
+	for name, group := range wanted {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool { return group[i] < group[j] })
+		var used []string
+		for _, fullName := range group {
+			used = append(used, fmt.Sprintf("%q for %s", resolved[fullName], fullName))
+		}
+		warnings = append(warnings, fmt.Sprintf("%s name %q collides, using %s", kind, name, strings.Join(used, ", ")))
+	}
+	sort.Strings(warnings)
+	return resolved, warnings
+}
+
+// strategyName returns the name of the descriptor under the naming strategy.
+func strategyName(desc protoreflect.Descriptor, strategy string) string {
+	pkg := desc.ParentFile().Package()
+	nested := strings.TrimPrefix(string(desc.FullName()), string(pkg)+".")
+	switch strategy {
+	case "short":
+		return string(desc.Name())
+	case "nested":
+		return strings.ReplaceAll(nested, ".", "_")
+	case "package_prefixed":
+		name := strings.ReplaceAll(nested, ".", "_")
+		if pkg == "" {
+			return name
+		}
+		last := string(pkg.Name())
+		return strings.ToUpper(last[:1]) + last[1:] + name
+	}
+	return string(desc.FullName())
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var namingSources = map[string]string{
+	"library.proto": `
+		syntax = "proto3";
+		package example.v1;
+		import "google/api/annotations.proto";
+		import "errors.proto";
+		service Library {
+			rpc GetShelf(Shelf) returns (Shelf) {
+				option (google.api.http) = {get: "/v1/shelves"};
+			}
+		}
+		message Shelf {
+			message Slot {
+				int32 index = 1;
+			}
+			repeated Slot slots = 1;
+			Error error = 2;
+			errors.v1.Error cause = 3;
+		}
+		message Error {
+			string message = 1;
+		}
+	`,
+	"errors.proto": `
+		syntax = "proto3";
+		package errors.v1;
+		message Error {
+			string reason = 1;
+		}
+	`,
+}
+
+func TestNaming(t *testing.T) {
+	for _, tt := range []struct {
+		strategy    string
+		shelf       string
+		slot        string
+		error       string
+		errorsError string
+		operationID string
+	}{
+		{"fqn", "example.v1.Shelf", "example.v1.Shelf.Slot", "example.v1.Error", "errors.v1.Error", "example_v1_Library_GetShelf"},
+		{"short", "Shelf", "Slot", "example.v1.Error", "errors.v1.Error", "Library_GetShelf"},
+		{"nested", "Shelf", "Shelf_Slot", "example.v1.Error", "errors.v1.Error", "Library_GetShelf"},
+		{"package_prefixed", "V1Shelf", "V1Shelf_Slot", "example.v1.Error", "errors.v1.Error", "V1Library_GetShelf"},
+	} {
+		doc := generateYAML(t, namingSources, "naming="+tt.strategy)
+		schemas := mustLookup(t, doc, "components", "schemas")
+		mustLookup(t, schemas, tt.shelf)
+		assertPath(t, schemas, "#/components/schemas/"+tt.slot, tt.shelf, "properties", "slots", "items", "$ref")
+		assertPath(t, schemas, "#/components/schemas/"+tt.error, tt.shelf, "properties", "error", "$ref")
+		assertPath(t, schemas, "#/components/schemas/"+tt.errorsError, tt.shelf, "properties", "cause", "$ref")
+		// The error response adds google.rpc.Status and google.protobuf.Any.
+		if n := len(schemas.(map[string]interface{})); n != 6 {
+			t.Errorf("naming=%s: got %d schemas, want 6", tt.strategy, n)
+		}
+		assertPath(t, doc, tt.operationID, "paths", "/v1/shelves", "get", "operationId")
+	}
+}
+
+func TestNamingCollisions(t *testing.T) {
+	gen, err := protogen.Options{}.New(newRequest(t, namingSources, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, warnings := newNames(gen, "short")
+	want := []string{`schema name "Error" collides, using "errors.v1.Error" for errors.v1.Error, "example.v1.Error" for example.v1.Error`}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("got warnings %q, want %q", warnings, want)
+	}
+	if got := names.schemas["example.v1.Shelf"]; got != "Shelf" {
+		t.Errorf("got name %q for example.v1.Shelf, want Shelf", got)
+	}
+
+	if _, warnings := newNames(gen, "fqn"); len(warnings) > 0 {
+		t.Errorf("got warnings %q for fully-qualified names", warnings)
+	}
+}
+
+func TestNamingServiceCollisions(t *testing.T) {
+	gen, err := protogen.Options{}.New(newRequest(t, map[string]string{
+		"a.proto": `
+			syntax = "proto3";
+			package a.v1;
+			service Library {}
+			service Shelves {}
+		`,
+		"b.proto": `
+			syntax = "proto3";
+			package b.v1;
+			service Library {}
+		`,
+	}, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, warnings := newNames(gen, "short")
+	for fullName, want := range map[protoreflect.FullName]string{
+		"a.v1.Library": "a.v1.Library",
+		"b.v1.Library": "b.v1.Library",
+		"a.v1.Shelves": "Shelves",
+	} {
+		if got := names.services[fullName]; got != want {
+			t.Errorf("got service name %q for %s, want %q", got, fullName, want)
+		}
+	}
+	want := []string{`service name "Library" collides, using "a.v1.Library" for a.v1.Library, "b.v1.Library" for b.v1.Library`}
+	if !reflect.DeepEqual(warnings, want) {
+		t.Errorf("got warnings %q, want %q", warnings, want)
+	}
+}
+
+func TestStrategyName(t *testing.T) {
+	gen, err := protogen.Options{}.New(newRequest(t, namingSources, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	slot := gen.FilesByPath["library.proto"].Messages[0].Messages[0].Desc
+	for strategy, want := range map[string]string{
+		"fqn":              "example.v1.Shelf.Slot",
+		"short":            "Slot",
+		"nested":           "Shelf_Slot",
+		"package_prefixed": "V1Shelf_Slot",
+	} {
+		if got := strategyName(slot, strategy); got != want {
+			t.Errorf("strategyName(%s, %q) = %q, want %q", slot.FullName(), strategy, got, want)
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// paths returns a path item for every route declared by a google.api.http rule on the methods of the request, and a tag describing every service with such routes.
+func (g *generator) paths() (Paths, []Tag, error) {
+	var paths Paths
+	var tags []Tag
+	for _, f := range g.doc.files {
+		for _, s := range f.Services {
+			// Tag names must be unique, so they are the names of the services given by the naming strategy.
+			tag := Tag{Name: g.names.service(s.Desc), Description: g.description(s.Comments)}
+			mergeTag(&tag, tagOption(s.Desc))
+			routed := false
+			for _, m := range s.Methods {
+				rule, ok := proto.GetExtension(m.Desc.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if !ok || rule == nil {
+					continue
+				}
+				base := Operation{
+					Tags:        []string{tag.Name},
+					OperationID: operationIDName(g.names.service(s.Desc)) + "_" + string(m.Desc.Name()),
+					Security:    security(securityOption(s.Desc)),
+				}
+				if a := operationOption(m.Desc); a.GetOperationId() != "" {
+					base.OperationID = a.GetOperationId()
+				}
+				if err := g.addOperation(&paths, base, m, rule); err != nil {
+					return nil, nil, fmt.Errorf("%s: %v", m.Desc.FullName(), err)
+				}
+				// Additional bindings route the same method on other paths, which OpenAPI describes as operations of their own.
+				for i, binding := range rule.AdditionalBindings {
+					additional := base
+					additional.OperationID = fmt.Sprintf("%s_%d", base.OperationID, i+1)
+					if err := g.addOperation(&paths, additional, m, binding); err != nil {
+						return nil, nil, fmt.Errorf("%s: additional binding %d: %v", m.Desc.FullName(), i+1, err)
+					}
+				}
+				routed = true
+			}
+			if routed {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return paths, tags, nil
+}
+
+// addOperation adds the operation routed by the HTTP rule to the path item of its path template. The base operation holds the tags, operation ID and security requirements given by the service and the binding. Rules with a custom HTTP method that OpenAPI cannot describe, and rules routing to a method and path that already have an operation, are skipped with a warning.
+func (g *generator) addOperation(paths *Paths, base Operation, m *protogen.Method, rule *annotations.HttpRule) error {
+	verb, template := httpRulePattern(rule)
+	if verb == "" {
+		return fmt.Errorf("http rule has no pattern")
+	}
+	path, params := parsePathTemplate(template)
+	path, params = equivalentPath(*paths, path, params)
+	item, ok := paths.Get(path)
+	if !ok {
+		item = &PathItem{}
+	}
+	slot := operationSlot(item, verb)
+	if slot == nil {
+		g.warnings = append(g.warnings, fmt.Sprintf("%s: custom HTTP method %q cannot be described in OpenAPI, skipping %s", m.Desc.FullName(), verb, template))
+		return nil
+	}
+	if *slot != nil {
+		g.warnings = append(g.warnings, fmt.Sprintf("%s: %s %s is already routed to operation %s, skipping", m.Desc.FullName(), strings.ToUpper(verb), path, (*slot).OperationID))
+		return nil
+	}
+	summary, description := summarize(g.description(m.Comments))
+	op := &base
+	op.Summary = summary
+	op.Description = description
+	if g.conf.EmptyNoContent && rule.ResponseBody == "" && m.Output.Desc.FullName() == "google.protobuf.Empty" {
+		op.Responses = &Responses{Codes: map[string]ResponseOrReference{"204": Response{Description: "No Content"}}}
+	} else {
+		response := &Schema{Ref: g.messageRef(m.Output)}
+		if rule.ResponseBody != "" {
+			field, err := findField(m.Output, rule.ResponseBody)
+			if err != nil {
+				return fmt.Errorf("response_body: %v", err)
+			}
+			response = g.fieldSchema(field, false)
+		}
+		op.Responses = &Responses{Codes: map[string]ResponseOrReference{
+			"200": Response{
+				Description: "OK",
+				Content: map[string]MediaType{
+					"application/json": {Schema: response},
+				},
+			},
+		}}
+	}
+	for _, p := range params {
+		field, err := findField(m.Input, p.field)
+		if err != nil {
+			return err
+		}
+		param := Parameter{
+			Name:     p.name,
+			In:       "path",
+			Required: true,
+			Schema:   g.fieldSchema(field, true),
+		}
+		if p.segment {
+			param.Description = fmt.Sprintf("The %s segment of %s.", p.name, p.field)
+			param.Schema = &Schema{Type: SchemaType{"string"}}
+		}
+		op.Parameters = append(op.Parameters, param)
+	}
+	if rule.Body != "*" {
+		bound := map[string]bool{rule.Body: true}
+		for _, p := range params {
+			bound[p.field] = true
+		}
+		op.Parameters = append(op.Parameters, g.queryParameters(m.Input, "", "", bound, nil)...)
+	}
+	switch rule.Body {
+	case "":
+	case "*":
+		op.RequestBody = RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				"application/json": {Schema: &Schema{Ref: g.inputRef(m.Input)}},
+			},
+		}
+	default:
+		field, err := findField(m.Input, rule.Body)
+		if err != nil {
+			return err
+		}
+		op.RequestBody = RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				"application/json": {Schema: g.fieldSchema(field, true)},
+			},
+		}
+	}
+
+	mergeOperation(op, operationOption(m.Desc))
+	*slot = op
+	if !ok {
+		paths.Set(path, item)
+	}
+	return nil
+}
+
+// operationSlot returns the field of the path item holding the operation of the lower-case HTTP method, or nil for methods that OpenAPI does not support.
+func operationSlot(item *PathItem, verb string) **Operation {
+	switch verb {
+	case "get":
+		return &item.Get
+	case "put":
+		return &item.Put
+	case "post":
+		return &item.Post
+	case "delete":
+		return &item.Delete
+	case "patch":
+		return &item.Patch
+	case "head":
+		return &item.Head
+	case "options":
+		return &item.Options
+	case "trace":
+		return &item.Trace
+	}
+	return nil
+}
+
+// queryParameters returns a query parameter for every field of the message that is not bound to the path or the body, as grpc-gateway parses them. The fields of nested messages are flattened to dotted names, except for recursive messages. Only the required fields of the message itself are required parameters. Map fields, repeated message fields and messages without a scalar JSON form cannot be written in a query string and are left out.
+func (g *generator) queryParameters(m *protogen.Message, protoPrefix, namePrefix string, bound map[string]bool, visited []*protogen.Message) []ParameterOrReference {
+	for _, v := range visited {
+		if v == m {
+			return nil
+		}
+	}
+	visited = append(visited, m)
+	var params []ParameterOrReference
+	for _, f := range m.Fields {
+		protoPath := protoPrefix + string(f.Desc.Name())
+		name := namePrefix + g.propertyName(f)
+		if bound[protoPath] || f.Desc.IsMap() {
+			continue
+		}
+		var schema *Schema
+		switch {
+		case f.Desc.Kind() == protoreflect.EnumKind:
+			schema = g.enumSchema(f.Enum, true)
+		case f.Message != nil:
+			schema = g.wellKnownSchema(f.Message.Desc)
+			if schema == nil {
+				if f.Desc.Cardinality() != protoreflect.Repeated {
+					params = append(params, g.queryParameters(f.Message, protoPath+".", name+".", bound, visited)...)
+				}
+				continue
+			}
+			if !isScalarSchema(schema) {
+				continue
+			}
+		default:
+			schema = g.scalarSchema(f.Desc.Kind())
+		}
+		p := Parameter{
+			Name:        name,
+			In:          "query",
+			Description: g.description(f.Comments),
+			Deprecated:  f.Desc.Options().(*descriptorpb.FieldOptions).GetDeprecated(),
+			Schema:      schema,
+		}
+		if f.Desc.Cardinality() == protoreflect.Repeated {
+			p.Schema = &Schema{Type: SchemaType{"array"}, Items: schema}
+			p.Style = "form"
+			p.Explode = true
+		}
+		// The description of a property option describes the parameter rather than its schema.
+		if a := propertyOption(f.Desc); a != nil {
+			mergeSchema(p.Schema, a)
+			setString(&p.Description, a.Description)
+			p.Schema.Description = ""
+		}
+		// A required field of a nested message is only required when its message is set, which a query string cannot express.
+		behaviors, _ := proto.GetExtension(f.Desc.Options(), annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+		for _, b := range behaviors {
+			p.Required = p.Required || (b == annotations.FieldBehavior_REQUIRED && protoPrefix == "")
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+// isScalarSchema reports whether values of the schema of a well-known type are written as a single string, number or boolean.
+func isScalarSchema(s *Schema) bool {
+	if len(s.OneOf) > 0 {
+		for _, branch := range s.OneOf {
+			if !isScalarSchema(branch) {
+				return false
+			}
+		}
+		return true
+	}
+	if len(s.Type) == 0 {
+		return false
+	}
+	for _, t := range s.Type {
+		if t == "object" || t == "array" {
+			return false
+		}
+	}
+	return true
+}
+
+// httpRulePattern returns the lower-case HTTP method and the path template of the rule. The method of a custom pattern is returned as declared, lower-cased.
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
+	switch p := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return "get", p.Get
+	case *annotations.HttpRule_Put:
+		return "put", p.Put
+	case *annotations.HttpRule_Post:
+		return "post", p.Post
+	case *annotations.HttpRule_Delete:
+		return "delete", p.Delete
+	case *annotations.HttpRule_Patch:
+		return "patch", p.Patch
+	case *annotations.HttpRule_Custom:
+		if p.Custom == nil || p.Custom.Kind == "" {
+			return "", ""
+		}
+		return strings.ToLower(p.Custom.Kind), p.Custom.Path
+	}
+	return "", ""
+}
+
+// pathParameter is a parameter of an OpenAPI path template, bound to a field of the request.
+type pathParameter struct {
+	// name is the name of the parameter in the path template.
+	name string
+	// field is the field path of the variable binding the parameter, such as book.name.
+	field string
+	// segment is set when the parameter is a single segment of a variable matching several, such as the shelf of shelves/*/books/*, rather than the whole value of the field.
+	segment bool
+}
+
+// parsePathTemplate converts a google.api.http path template into an OpenAPI path template and returns its parameters. The literal segments of a variable matching several segments are written into the path, and each of its wildcards becomes a parameter named after the singular of the preceding segment, so /v1/{name=shelves/*/books/*} becomes /v1/shelves/{shelf}/books/{book}. OpenAPI parameters cannot contain slashes, so this keeps routes to resources of different kinds apart and lets clients send resource names unescaped. Other variables, such as {parent} or {name=**}, become a parameter named after their field path.
+func parsePathTemplate(template string) (string, []pathParameter) {
+	var path strings.Builder
+	var params []pathParameter
+	used := map[string]bool{}
+	add := func(name, field string, segment bool) {
+		unique := name
+		for i := 2; used[unique]; i++ {
+			unique = fmt.Sprintf("%s_%d", name, i)
+		}
+		used[unique] = true
+		params = append(params, pathParameter{name: unique, field: field, segment: segment})
+		path.WriteString("{" + unique + "}")
+	}
+	for {
+		start := strings.IndexByte(template, '{')
+		end := strings.IndexByte(template, '}')
+		if start < 0 || end < start {
+			path.WriteString(template)
+			return path.String(), params
+		}
+		path.WriteString(template[:start])
+		field, pattern := template[start+1:end], "*"
+		if i := strings.IndexByte(field, '='); i >= 0 {
+			field, pattern = field[:i], field[i+1:]
+		}
+		template = template[end+1:]
+		segments := strings.Split(pattern, "/")
+		if len(segments) == 1 {
+			add(field, field, false)
+			continue
+		}
+		previous := ""
+		for i, s := range segments {
+			if i > 0 {
+				path.WriteByte('/')
+			}
+			if s != "*" && s != "**" {
+				path.WriteString(s)
+				previous = s
+				continue
+			}
+			name := field
+			if previous != "" {
+				name = singular(previous)
+			}
+			add(name, field, true)
+		}
+	}
+}
+
+// singular returns the singular of the plural English noun naming a collection of resources, such as shelf for shelves, or the word itself if it does not look plural.
+func singular(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 3:
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "lves"):
+		return strings.TrimSuffix(word, "ves") + "f"
+	case strings.HasSuffix(word, "sses"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "xes"), strings.HasSuffix(word, "zes"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && len(word) > 1:
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// pathParameterPattern matches the parameters of an OpenAPI path template.
+var pathParameterPattern = regexp.MustCompile(`\{[^}]*\}`)
+
+// equivalentPath returns the path of the paths that differs from the path only by the names of its parameters, with the parameters renamed after those of that path. OpenAPI considers such paths identical, so their operations must share one path item. It returns the path and parameters unchanged when there is none.
+func equivalentPath(paths Paths, path string, params []pathParameter) (string, []pathParameter) {
+	key := pathParameterPattern.ReplaceAllString(path, "{}")
+	for _, existing := range paths.Keys() {
+		if existing == path || pathParameterPattern.ReplaceAllString(existing, "{}") != key {
+			continue
+		}
+		names := pathParameterPattern.FindAllString(existing, -1)
+		renamed := make([]pathParameter, len(params))
+		for i, p := range params {
+			p.name = strings.Trim(names[i], "{}")
+			renamed[i] = p
+		}
+		return existing, renamed
+	}
+	return path, params
+}
+
+// findField returns the field of the message named by a dot-separated path of proto field names, such as book.name.
+func findField(m *protogen.Message, fieldPath string) (*protogen.Field, error) {
+	var field *protogen.Field
+	for _, name := range strings.Split(fieldPath, ".") {
+		if m == nil {
+			return nil, fmt.Errorf("field path %q traverses a non-message field", fieldPath)
+		}
+		field = nil
+		for _, f := range m.Fields {
+			if string(f.Desc.Name()) == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("field path %q does not name a field of %s", fieldPath, m.Desc.FullName())
+		}
+		m = field.Message
+	}
+	return field, nil
+}
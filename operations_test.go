@@ -0,0 +1,417 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"gopkg.in/yaml.v3"
+)
+
+const libraryProto = `
+	syntax = "proto3";
+	package example.v1;
+	import "google/api/annotations.proto";
+	service Library {
+		rpc GetBook(GetBookRequest) returns (Book) {
+			option (google.api.http) = {get: "/v1/{name=shelves/*/books/*}"};
+		}
+		rpc CreateBook(CreateBookRequest) returns (Book) {
+			option (google.api.http) = {post: "/v1/{parent=shelves/*}/books", body: "book"};
+		}
+		rpc UpdateBook(UpdateBookRequest) returns (Book) {
+			option (google.api.http) = {patch: "/v1/{book.name=shelves/*/books/*}", body: "*"};
+		}
+		rpc DeleteBook(GetBookRequest) returns (Book) {
+			option (google.api.http) = {delete: "/v1/{name=shelves/*/books/*}"};
+		}
+		rpc Ping(GetBookRequest) returns (Book);
+	}
+	message Book {
+		string name = 1;
+		string title = 2;
+	}
+	message GetBookRequest {
+		string name = 1;
+	}
+	message CreateBookRequest {
+		string parent = 1;
+		Book book = 2;
+	}
+	message UpdateBookRequest {
+		Book book = 1;
+	}
+`
+
+func TestPaths(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": libraryProto}, "")
+	paths := mustLookup(t, doc, "paths")
+	if n := len(paths.(map[string]interface{})); n != 2 {
+		t.Errorf("got %d paths, want 2", n)
+	}
+
+	get := mustLookup(t, paths, "/v1/shelves/{shelf}/books/{book}", "get")
+	assertPath(t, get, "example_v1_Library_GetBook", "operationId")
+	assertPath(t, get, []interface{}{"example.v1.Library"}, "tags")
+	assertPath(t, get, "shelf", "parameters", 0, "name")
+	assertPath(t, get, "path", "parameters", 0, "in")
+	assertPath(t, get, true, "parameters", 0, "required")
+	assertPath(t, get, "string", "parameters", 0, "schema", "type")
+	assertPath(t, get, "book", "parameters", 1, "name")
+	assertPath(t, get, "#/components/schemas/example.v1.Book", "responses", "200", "content", "application/json", "schema", "$ref")
+	assertNoPath(t, get, "requestBody")
+	assertPath(t, paths, "example_v1_Library_DeleteBook", "/v1/shelves/{shelf}/books/{book}", "delete", "operationId")
+	assertNoPath(t, paths, "/v1/shelves/{shelf}/books/{book}", "put")
+
+	create := mustLookup(t, paths, "/v1/shelves/{shelf}/books", "post")
+	assertPath(t, create, "shelf", "parameters", 0, "name")
+	assertPath(t, create, "The shelf segment of parent.", "parameters", 0, "description")
+	assertPath(t, create, "#/components/schemas/example.v1.Book", "requestBody", "content", "application/json", "schema", "$ref")
+
+	// The variable of UpdateBook binds book.name rather than name, but routes to the same path.
+	update := mustLookup(t, paths, "/v1/shelves/{shelf}/books/{book}", "patch")
+	assertPath(t, update, "shelf", "parameters", 0, "name")
+	assertPath(t, update, "The shelf segment of book.name.", "parameters", 0, "description")
+	assertPath(t, update, "#/components/schemas/example.v1.UpdateBookRequest", "requestBody", "content", "application/json", "schema", "$ref")
+}
+
+func TestTags(t *testing.T) {
+	sources := map[string]string{
+		"a.proto": `
+			syntax = "proto3";
+			package a.v1;
+			import "google/api/annotations.proto";
+			service Library {
+				rpc Ping(Empty) returns (Empty) {
+					option (google.api.http) = {get: "/a/ping"};
+				}
+			}
+			message Empty {}
+		`,
+		"b.proto": `
+			syntax = "proto3";
+			package b.v1;
+			import "google/api/annotations.proto";
+			service Library {
+				rpc Ping(Empty) returns (Empty) {
+					option (google.api.http) = {get: "/b/ping"};
+				}
+			}
+			service Shelves {
+				rpc Ping(Empty) returns (Empty) {
+					option (google.api.http) = {get: "/b/shelves/ping"};
+				}
+			}
+			message Empty {}
+		`,
+	}
+	doc := generateYAML(t, sources, "naming=short")
+	assertPath(t, doc, []interface{}{
+		map[string]interface{}{"name": "a.v1.Library"},
+		map[string]interface{}{"name": "b.v1.Library"},
+		map[string]interface{}{"name": "Shelves"},
+	}, "tags")
+	assertPath(t, doc, []interface{}{"a.v1.Library"}, "paths", "/a/ping", "get", "tags")
+	assertPath(t, doc, []interface{}{"b.v1.Library"}, "paths", "/b/ping", "get", "tags")
+	assertPath(t, doc, []interface{}{"Shelves"}, "paths", "/b/shelves/ping", "get", "tags")
+}
+
+func TestPathsOrder(t *testing.T) {
+	out := runPlugin(t, newRequest(t, map[string]string{"library.proto": libraryProto}, ""))
+	var doc struct {
+		Paths yaml.Node `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal([]byte(out["openapi.yaml"]), &doc); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for i := 0; i < len(doc.Paths.Content); i += 2 {
+		got = append(got, doc.Paths.Content[i].Value)
+	}
+	want := []string{"/v1/shelves/{shelf}/books/{book}", "/v1/shelves/{shelf}/books"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got paths %q, want %q", got, want)
+	}
+}
+
+func TestParsePathTemplate(t *testing.T) {
+	for _, tt := range []struct {
+		template string
+		path     string
+		params   []pathParameter
+	}{
+		{"/v1/books", "/v1/books", nil},
+		{"/v1/{name=shelves/*/books/*}", "/v1/shelves/{shelf}/books/{book}", []pathParameter{{"shelf", "name", true}, {"book", "name", true}}},
+		{"/v1/{parent=libraries/*}/entries", "/v1/libraries/{library}/entries", []pathParameter{{"library", "parent", true}}},
+		{"/v1/{parent}/books/{book_id}", "/v1/{parent}/books/{book_id}", []pathParameter{{"parent", "parent", false}, {"book_id", "book_id", false}}},
+		{"/v1/{book.name=**}:publish", "/v1/{book.name}:publish", []pathParameter{{"book.name", "book.name", false}}},
+		{"/v1/{name=*/books/*}", "/v1/{name}/books/{book}", []pathParameter{{"name", "name", true}, {"book", "name", true}}},
+		{"/v1/{name=books/*/books/*}", "/v1/books/{book}/books/{book_2}", []pathParameter{{"book", "name", true}, {"book_2", "name", true}}},
+		{"/v1/{name=files/**}", "/v1/files/{file}", []pathParameter{{"file", "name", true}}},
+	} {
+		path, params := parsePathTemplate(tt.template)
+		if path != tt.path || !reflect.DeepEqual(params, tt.params) {
+			t.Errorf("parsePathTemplate(%q) = %q, %+v, want %q, %+v", tt.template, path, params, tt.path, tt.params)
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	for word, want := range map[string]string{
+		"shelves":   "shelf",
+		"books":     "book",
+		"libraries": "library",
+		"addresses": "address",
+		"branches":  "branch",
+		"boxes":     "box",
+		"access":    "access",
+		"data":      "data",
+	} {
+		if got := singular(word); got != want {
+			t.Errorf("singular(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestResourcePaths(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"library.proto": `
+			syntax = "proto3";
+			package example.v1;
+			import "google/api/annotations.proto";
+			service Library {
+				rpc GetShelf(GetRequest) returns (Resource) {
+					option (google.api.http) = {get: "/v1/{name=shelves/*}"};
+				}
+				rpc GetBook(GetRequest) returns (Resource) {
+					option (google.api.http) = {get: "/v1/{name=shelves/*/books/*}"};
+				}
+				rpc GetObject(GetRequest) returns (Resource) {
+					option (google.api.http) = {get: "/v1/{name=**}"};
+				}
+				rpc GetEntry(GetRequest) returns (Resource) {
+					option (google.api.http) = {get: "/v2/{name}"};
+				}
+				rpc UpdateEntry(UpdateRequest) returns (Resource) {
+					option (google.api.http) = {patch: "/v2/{resource.name}", body: "resource"};
+				}
+			}
+			message Resource {
+				string name = 1;
+			}
+			message GetRequest {
+				string name = 1;
+			}
+			message UpdateRequest {
+				Resource resource = 1;
+			}
+		`,
+	}, "")
+	paths := mustLookup(t, doc, "paths")
+	assertPath(t, paths, "example_v1_Library_GetShelf", "/v1/shelves/{shelf}", "get", "operationId")
+	assertPath(t, paths, "example_v1_Library_GetBook", "/v1/shelves/{shelf}/books/{book}", "get", "operationId")
+	assertPath(t, paths, "example_v1_Library_GetObject", "/v1/{name}", "get", "operationId")
+	book := mustLookup(t, paths, "/v1/shelves/{shelf}/books/{book}", "get", "parameters")
+	assertPath(t, book, "shelf", 0, "name")
+	assertPath(t, book, "path", 0, "in")
+	assertPath(t, book, true, 0, "required")
+	assertPath(t, book, "string", 0, "schema", "type")
+	assertPath(t, book, "The shelf segment of name.", 0, "description")
+	assertPath(t, book, "book", 1, "name")
+	assertNoPath(t, book, 2)
+
+	// Paths differing only by the names of their parameters are the same path in OpenAPI.
+	assertPath(t, paths, "example_v1_Library_GetEntry", "/v2/{name}", "get", "operationId")
+	assertPath(t, paths, "example_v1_Library_UpdateEntry", "/v2/{name}", "patch", "operationId")
+	assertPath(t, paths, "name", "/v2/{name}", "patch", "parameters", 0, "name")
+	assertNoPath(t, paths, "/v2/{resource.name}")
+}
+
+func TestQueryParameters(t *testing.T) {
+	doc := generateYAML(t, map[string]string{
+		"search.proto": `
+			syntax = "proto3";
+			package example.v1;
+			import "google/api/annotations.proto";
+			import "google/api/field_behavior.proto";
+			import "google/protobuf/timestamp.proto";
+			import "google/protobuf/struct.proto";
+			service Search {
+				rpc ListBooks(ListBooksRequest) returns (ListBooksResponse) {
+					option (google.api.http) = {get: "/v1/{parent=shelves/*}/books"};
+				}
+				rpc MoveBook(MoveBookRequest) returns (ListBooksResponse) {
+					option (google.api.http) = {post: "/v1/{name=books/*}:move", body: "destination"};
+				}
+				rpc ReplaceBooks(ListBooksRequest) returns (ListBooksResponse) {
+					option (google.api.http) = {put: "/v1/{parent=shelves/*}/books", body: "*"};
+				}
+			}
+			enum Status {
+				STATUS_UNSPECIFIED = 0;
+				STATUS_AVAILABLE = 1;
+			}
+			message Filter {
+				Status status = 1 [(google.api.field_behavior) = REQUIRED];
+				google.protobuf.Timestamp updated_after = 2;
+				Filter and = 3;
+			}
+			message ListBooksRequest {
+				string parent = 1;
+				// The maximum number of books to return.
+				int32 page_size = 2 [(google.api.field_behavior) = REQUIRED];
+				repeated string authors = 3;
+				Filter filter = 4;
+				map<string, string> labels = 5;
+				repeated Filter filters = 6;
+				google.protobuf.Struct metadata = 7;
+				int64 legacy_id = 8 [deprecated = true];
+			}
+			message ListBooksResponse {}
+			message MoveBookRequest {
+				string name = 1;
+				string destination = 2;
+				bool validate_only = 3;
+			}
+		`,
+	}, "")
+	paths := mustLookup(t, doc, "paths")
+	list := mustLookup(t, paths, "/v1/shelves/{shelf}/books", "get", "parameters")
+	var names []string
+	for _, p := range list.([]interface{}) {
+		names = append(names, p.(map[string]interface{})["name"].(string))
+	}
+	want := []string{"shelf", "pageSize", "authors", "filter.status", "filter.updatedAfter", "legacyId"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("got parameters %q, want %q", names, want)
+	}
+	assertPath(t, list, "query", 1, "in")
+	assertPath(t, list, true, 1, "required")
+	assertPath(t, list, "The maximum number of books to return.", 1, "description")
+	assertPath(t, list, "integer", 1, "schema", "type")
+	assertPath(t, list, "array", 2, "schema", "type")
+	assertPath(t, list, "string", 2, "schema", "items", "type")
+	assertPath(t, list, "form", 2, "style")
+	assertPath(t, list, true, 2, "explode")
+	assertPath(t, list, []interface{}{"STATUS_UNSPECIFIED", "STATUS_AVAILABLE"}, 3, "schema", "enum")
+	assertNoPath(t, list, 3, "required")
+	assertPath(t, list, "date-time", 4, "schema", "format")
+	assertPath(t, list, true, 5, "deprecated")
+	assertNoPath(t, list, 0, "style")
+
+	move := mustLookup(t, paths, "/v1/books/{book}:move", "post", "parameters")
+	assertPath(t, move, "book", 0, "name")
+	assertPath(t, move, "validateOnly", 1, "name")
+	assertNoPath(t, move, 2)
+
+	replace := mustLookup(t, paths, "/v1/shelves/{shelf}/books", "put", "parameters")
+	assertNoPath(t, replace, 1)
+}
+
+func TestHTTPRuleGrammar(t *testing.T) {
+	sources := map[string]string{
+		"library.proto": `
+			syntax = "proto3";
+			package example.v1;
+			import "google/api/annotations.proto";
+			service Library {
+				rpc GetBook(GetBookRequest) returns (Book) {
+					option (google.api.http) = {
+						get: "/v1/{name=shelves/*/books/*}"
+						additional_bindings {get: "/v1/books/{name}"}
+						additional_bindings {post: "/v1/books/{name}:get", body: "*"}
+					};
+				}
+				rpc PublishBook(GetBookRequest) returns (Book) {
+					option (google.api.http) = {post: "/v1/{name=books/*}:publish", body: "*", response_body: "title"};
+				}
+				rpc CheckBook(GetBookRequest) returns (Book) {
+					option (google.api.http) = {custom: {kind: "HEAD", path: "/v1/{name=books/*}"}};
+				}
+				rpc SubscribeBook(GetBookRequest) returns (Book) {
+					option (google.api.http) = {custom: {kind: "SUBSCRIBE", path: "/v1/{name=books/*}"}};
+				}
+				rpc FindBook(GetBookRequest) returns (Book) {
+					option (google.api.http) = {get: "/v1/{name=books/*}"};
+				}
+			}
+			message Book {
+				string name = 1;
+				repeated string title = 2;
+			}
+			message GetBookRequest {
+				string name = 1;
+			}
+		`,
+	}
+	req := newRequest(t, sources, "")
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, _ := newNames(gen, "fqn")
+	g := newGenerator(gen, newConfig(), &documentFile{name: "openapi", files: gen.Files}, map[string]string{}, names, "yaml")
+	d, err := g.document()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.warnings) != 2 {
+		t.Errorf("got warnings %q, want 2", g.warnings)
+	}
+	out, err := yaml.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc interface{}
+	if err := yaml.Unmarshal(out, &doc); err != nil {
+		t.Fatal(err)
+	}
+	paths := mustLookup(t, doc, "paths")
+	assertPath(t, paths, "example_v1_Library_GetBook", "/v1/shelves/{shelf}/books/{book}", "get", "operationId")
+	assertPath(t, paths, "example_v1_Library_GetBook_1", "/v1/books/{name}", "get", "operationId")
+	assertPath(t, paths, "example_v1_Library_GetBook_2", "/v1/books/{name}:get", "post", "operationId")
+	assertPath(t, paths, "#/components/schemas/example.v1.GetBookRequest", "/v1/books/{name}:get", "post", "requestBody", "content", "application/json", "schema", "$ref")
+	publish := mustLookup(t, paths, "/v1/books/{book}:publish", "post")
+	assertPath(t, publish, "array", "responses", "200", "content", "application/json", "schema", "type")
+	assertPath(t, publish, "string", "responses", "200", "content", "application/json", "schema", "items", "type")
+	// CheckBook routes to /v1/books/{book}, the path of the first additional binding of GetBook with another parameter name.
+	assertPath(t, paths, "example_v1_Library_CheckBook", "/v1/books/{name}", "head", "operationId")
+	assertNoPath(t, paths, "/v1/books/{name}", "subscribe")
+	assertNoPath(t, paths, "/v1/books/{book}")
+}
+
+func TestEmptyNoContent(t *testing.T) {
+	sources := map[string]string{
+		"library.proto": `
+			syntax = "proto3";
+			package example.v1;
+			import "google/api/annotations.proto";
+			import "google/protobuf/empty.proto";
+			import "openapi/v3/annotations.proto";
+			service Library {
+				rpc DeleteBook(DeleteBookRequest) returns (google.protobuf.Empty) {
+					option (google.api.http) = {delete: "/v1/{name=books/*}"};
+					option (protoc_gen_openapi.v3.operation) = {
+						responses: {key: "4xx", value: {}}
+					};
+				}
+			}
+			message DeleteBookRequest {
+				string name = 1;
+			}
+		`,
+	}
+	doc := generateYAML(t, sources, "")
+	responses := mustLookup(t, doc, "paths", "/v1/books/{book}", "delete", "responses")
+	assertPath(t, responses, "#/components/schemas/google.protobuf.Empty", "200", "content", "application/json", "schema", "$ref")
+	assertPath(t, responses, "Client Error", "4XX", "description")
+	assertNoPath(t, responses, "4xx")
+
+	doc = generateYAML(t, sources, "empty_no_content=true")
+	responses = mustLookup(t, doc, "paths", "/v1/books/{book}", "delete", "responses")
+	assertPath(t, responses, "No Content", "204", "description")
+	assertNoPath(t, responses, "204", "content")
+	assertNoPath(t, responses, "200")
+	assertNoPath(t, doc, "components", "schemas", "google.protobuf.Empty")
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// orderedEntry is a single key and value of an orderedMap.
+type orderedEntry[V any] struct {
+	Key   string
+	Value V
+}
+
+// orderedMap is a string-keyed map that is marshalled in insertion order, so that documents follow the declaration order of the proto files instead of the sorted order of Go maps.
+type orderedMap[V any] []orderedEntry[V]
+
+// sortedMap returns the entries of the map as an orderedMap sorted by key.
+func sortedMap[V any](m map[string]V) orderedMap[V] {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sorted := make(orderedMap[V], len(keys))
+	for i, key := range keys {
+		sorted[i] = orderedEntry[V]{Key: key, Value: m[key]}
+	}
+	return sorted
+}
+
+// Get returns the value stored under the key.
+func (m orderedMap[V]) Get(key string) (V, bool) {
+	for _, e := range m {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Set stores the value under the key, replacing the value of an existing key in place.
+func (m *orderedMap[V]) Set(key string, value V) {
+	for i, e := range *m {
+		if e.Key == key {
+			(*m)[i].Value = value
+			return
+		}
+	}
+	*m = append(*m, orderedEntry[V]{Key: key, Value: value})
+}
+
+// Keys returns the keys of the map in insertion order.
+func (m orderedMap[V]) Keys() []string {
+	keys := make([]string, len(m))
+	for i, e := range m {
+		keys[i] = e.Key
+	}
+	return keys
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (m orderedMap[V]) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, e := range m {
+		var value yaml.Node
+		if err := value.Encode(e.Value); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: e.Key}, &value)
+	}
+	return node, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m orderedMap[V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, e := range m {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalJSON(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshalJSON(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshalJSON is like json.Marshal but leaves HTML characters such as < and & unescaped, as they are in the YAML output.
+func marshalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,492 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	openapiv3 "github.com/a27kash/protoc-gen-openapi/openapi/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The merge functions below apply the options of openapi/v3/annotations.proto over the values derived from the proto files. Strings, numbers and messages that are set replace derived values, true booleans are set, and lists replace derived lists, except where merging by name is documented in the proto file.
+
+// documentOption returns the protoc_gen_openapi.v3.document option of the file, or nil.
+func documentOption(desc protoreflect.FileDescriptor) *openapiv3.Document {
+	a, _ := proto.GetExtension(desc.Options(), openapiv3.E_Document).(*openapiv3.Document)
+	return a
+}
+
+// tagOption returns the protoc_gen_openapi.v3.tag option of the service, or nil.
+func tagOption(desc protoreflect.ServiceDescriptor) *openapiv3.Tag {
+	a, _ := proto.GetExtension(desc.Options(), openapiv3.E_Tag).(*openapiv3.Tag)
+	return a
+}
+
+// securityOption returns the protoc_gen_openapi.v3.security option of the service, or nil.
+func securityOption(desc protoreflect.ServiceDescriptor) *openapiv3.Security {
+	a, _ := proto.GetExtension(desc.Options(), openapiv3.E_Security).(*openapiv3.Security)
+	return a
+}
+
+// operationOption returns the protoc_gen_openapi.v3.operation option of the method, or nil.
+func operationOption(desc protoreflect.MethodDescriptor) *openapiv3.Operation {
+	a, _ := proto.GetExtension(desc.Options(), openapiv3.E_Operation).(*openapiv3.Operation)
+	return a
+}
+
+// schemaOption returns the protoc_gen_openapi.v3.schema option of the message, or nil.
+func schemaOption(desc protoreflect.MessageDescriptor) *openapiv3.Schema {
+	a, _ := proto.GetExtension(desc.Options(), openapiv3.E_Schema).(*openapiv3.Schema)
+	return a
+}
+
+// propertyOption returns the protoc_gen_openapi.v3.property option of the field, or nil.
+func propertyOption(desc protoreflect.FieldDescriptor) *openapiv3.Schema {
+	a, _ := proto.GetExtension(desc.Options(), openapiv3.E_Property).(*openapiv3.Schema)
+	return a
+}
+
+func mergeDocument(d *OpenAPI, a *openapiv3.Document) {
+	if a == nil {
+		return
+	}
+	mergeInfo(&d.Info, a.Info)
+	if len(a.Servers) > 0 {
+		d.Servers = servers(a.Servers)
+	}
+	for _, at := range a.Tags {
+		found := false
+		for i := range d.Tags {
+			if d.Tags[i].Name == at.Name {
+				mergeTag(&d.Tags[i], at)
+				found = true
+			}
+		}
+		if !found {
+			t := Tag{}
+			mergeTag(&t, at)
+			d.Tags = append(d.Tags, t)
+		}
+	}
+	if a.ExternalDocs != nil {
+		d.ExternalDocs = externalDocs(a.ExternalDocs)
+	}
+	for name, as := range a.SecuritySchemes {
+		if d.Components == nil {
+			d.Components = &Components{}
+		}
+		if d.Components.SecuritySchemes == nil {
+			d.Components.SecuritySchemes = map[string]SecuritySchemeOrReference{}
+		}
+		d.Components.SecuritySchemes[name] = securityScheme(as)
+	}
+	if s := security(a.Security); s != nil {
+		d.Security = *s
+	}
+}
+
+func mergeInfo(i *Info, a *openapiv3.Info) {
+	if a == nil {
+		return
+	}
+	setString(&i.Title, a.Title)
+	setString(&i.Summary, a.Summary)
+	setString(&i.Description, a.Description)
+	setString(&i.TermsOfService, a.TermsOfService)
+	setString(&i.Version, a.Version)
+	if a.Contact != nil {
+		i.Contact = &Contact{Name: a.Contact.Name, URL: a.Contact.Url, Email: a.Contact.Email}
+	}
+	if a.License != nil {
+		i.License = &License{Name: a.License.Name, Identifier: a.License.Identifier, URL: a.License.Url}
+	}
+}
+
+func mergeTag(t *Tag, a *openapiv3.Tag) {
+	if a == nil {
+		return
+	}
+	setString(&t.Name, a.Name)
+	setString(&t.Description, a.Description)
+	if a.ExternalDocs != nil {
+		t.ExternalDocs = externalDocs(a.ExternalDocs)
+	}
+}
+
+// mergeOperation merges the option over the operation. The operation ID is not merged, as it is shared by the operations of all bindings of the method and made unique by the caller.
+func mergeOperation(op *Operation, a *openapiv3.Operation) {
+	if a == nil {
+		return
+	}
+	if len(a.Tags) > 0 {
+		op.Tags = a.Tags
+	}
+	setString(&op.Summary, a.Summary)
+	setString(&op.Description, a.Description)
+	if a.ExternalDocs != nil {
+		op.ExternalDocs = externalDocs(a.ExternalDocs)
+	}
+	for _, ap := range a.Parameters {
+		found := false
+		for i, p := range op.Parameters {
+			if p, ok := p.(Parameter); ok && p.Name == ap.Name && p.In == ap.In {
+				mergeParameter(&p, ap)
+				op.Parameters[i] = p
+				found = true
+			}
+		}
+		if !found {
+			p := Parameter{}
+			mergeParameter(&p, ap)
+			op.Parameters = append(op.Parameters, p)
+		}
+	}
+	for code, ar := range a.Responses {
+		if op.Responses == nil {
+			op.Responses = &Responses{}
+		}
+		if code == "default" {
+			r, ok := op.Responses.Default.(Response)
+			if !ok {
+				r = Response{}
+			}
+			mergeResponse(&r, ar)
+			op.Responses.Default = r
+			continue
+		}
+		if op.Responses.Codes == nil {
+			op.Responses.Codes = map[string]ResponseOrReference{}
+		}
+		code = strings.ToUpper(code)
+		r, ok := op.Responses.Codes[code].(Response)
+		if !ok {
+			r = Response{Description: statusDescription(code)}
+		}
+		mergeResponse(&r, ar)
+		op.Responses.Codes[code] = r
+	}
+	op.Deprecated = op.Deprecated || a.Deprecated
+	if len(a.Servers) > 0 {
+		op.Servers = servers(a.Servers)
+	}
+	if s := security(a.Security); s != nil {
+		op.Security = s
+	}
+	if a.RequestBody != nil {
+		b, ok := op.RequestBody.(RequestBody)
+		if !ok {
+			b = RequestBody{}
+		}
+		setString(&b.Description, a.RequestBody.Description)
+		b.Content = mergeContent(b.Content, a.RequestBody.Content)
+		b.Required = b.Required || a.RequestBody.Required
+		op.RequestBody = b
+	}
+}
+
+// statusDescription returns the description of a new response for the status code or range of status codes, such as 4XX.
+func statusDescription(code string) string {
+	if len(code) == 3 && strings.HasSuffix(code, "XX") {
+		switch code[0] {
+		case '1':
+			return "Informational"
+		case '2':
+			return "Success"
+		case '3':
+			return "Redirection"
+		case '4':
+			return "Client Error"
+		case '5':
+			return "Server Error"
+		}
+	}
+	status, _ := strconv.Atoi(code)
+	return http.StatusText(status)
+}
+
+func mergeParameter(p *Parameter, a *openapiv3.Parameter) {
+	setString(&p.Name, a.Name)
+	setString(&p.In, a.In)
+	setString(&p.Description, a.Description)
+	p.Required = p.Required || a.Required || a.In == "path"
+	p.Deprecated = p.Deprecated || a.Deprecated
+	setString(&p.Style, a.Style)
+	p.Explode = p.Explode || a.Explode
+	if a.Schema != nil {
+		if p.Schema == nil {
+			p.Schema = &Schema{}
+		}
+		mergeSchema(p.Schema, a.Schema)
+	}
+	if a.Example != nil {
+		p.Example = a.Example.AsInterface()
+	}
+}
+
+func mergeResponse(r *Response, a *openapiv3.Response) {
+	setString(&r.Description, a.Description)
+	r.Content = mergeContent(r.Content, a.Content)
+	for name, ah := range a.Headers {
+		if r.Headers == nil {
+			r.Headers = map[string]HeaderOrReference{}
+		}
+		h := Header{Description: ah.Description, Required: ah.Required, Deprecated: ah.Deprecated}
+		if ah.Schema != nil {
+			h.Schema = &Schema{}
+			mergeSchema(h.Schema, ah.Schema)
+		}
+		if ah.Example != nil {
+			h.Example = ah.Example.AsInterface()
+		}
+		r.Headers[name] = h
+	}
+	for name, al := range a.Links {
+		if r.Links == nil {
+			r.Links = map[string]LinkOrReference{}
+		}
+		l := Link{OperationRef: al.OperationRef, OperationID: al.OperationId, Description: al.Description}
+		for name, v := range al.Parameters {
+			if l.Parameters == nil {
+				l.Parameters = map[string]interface{}{}
+			}
+			l.Parameters[name] = v.AsInterface()
+		}
+		if al.RequestBody != nil {
+			l.RequestBody = al.RequestBody.AsInterface()
+		}
+		if al.Server != nil {
+			l.Server = &servers([]*openapiv3.Server{al.Server})[0]
+		}
+		r.Links[name] = l
+	}
+}
+
+// mergeContent merges the media types of the option into the content by name, returning the content.
+func mergeContent(content map[string]MediaType, a map[string]*openapiv3.MediaType) map[string]MediaType {
+	for name, am := range a {
+		if content == nil {
+			content = map[string]MediaType{}
+		}
+		m := content[name]
+		if am.Schema != nil {
+			if m.Schema == nil {
+				m.Schema = &Schema{}
+			}
+			mergeSchema(m.Schema, am.Schema)
+		}
+		if am.Example != nil {
+			m.Example = am.Example.AsInterface()
+		}
+		content[name] = m
+	}
+	return content
+}
+
+// mergeSchema merges the option over the schema. A reference replaces the derived schema, keeping only the other values of the option.
+func mergeSchema(s *Schema, a *openapiv3.Schema) {
+	if a == nil {
+		return
+	}
+	if a.Ref != "" {
+		*s = Schema{Ref: a.Ref}
+	}
+	setString(&s.Title, a.Title)
+	setString(&s.Description, a.Description)
+	if len(a.Type) > 0 {
+		s.Type = SchemaType(a.Type)
+	}
+	setString(&s.Format, a.Format)
+	if len(a.Enum) > 0 {
+		s.Enum = values(a.Enum)
+	}
+	if a.Const != nil {
+		s.Const = a.Const.AsInterface()
+	}
+	if a.Default != nil {
+		s.Default = a.Default.AsInterface()
+	}
+	setFloat(&s.MultipleOf, a.MultipleOf)
+	setFloat(&s.Maximum, a.Maximum)
+	setFloat(&s.ExclusiveMaximum, a.ExclusiveMaximum)
+	setFloat(&s.Minimum, a.Minimum)
+	setFloat(&s.ExclusiveMinimum, a.ExclusiveMinimum)
+	setUint(&s.MaxLength, a.MaxLength)
+	setUint(&s.MinLength, a.MinLength)
+	setString(&s.Pattern, a.Pattern)
+	setUint(&s.MaxItems, a.MaxItems)
+	setUint(&s.MinItems, a.MinItems)
+	s.UniqueItems = s.UniqueItems || a.UniqueItems
+	setUint(&s.MaxProperties, a.MaxProperties)
+	setUint(&s.MinProperties, a.MinProperties)
+	for _, name := range a.Required {
+		if !containsString(s.Required, name) {
+			s.Required = append(s.Required, name)
+		}
+	}
+	s.ReadOnly = s.ReadOnly || a.ReadOnly
+	s.WriteOnly = s.WriteOnly || a.WriteOnly
+	s.Deprecated = s.Deprecated || a.Deprecated
+	if len(a.Examples) > 0 {
+		s.Examples = values(a.Examples)
+	}
+	if a.ExternalDocs != nil {
+		s.ExternalDocs = externalDocs(a.ExternalDocs)
+	}
+	for _, name := range sortedMap(a.Extensions).Keys() {
+		s.Extensions.Set(name, a.Extensions[name].AsInterface())
+	}
+	if a.Items != nil {
+		if s.Items == nil {
+			s.Items = &Schema{}
+		}
+		mergeSchema(s.Items, a.Items)
+	}
+	for _, name := range sortedMap(a.Properties).Keys() {
+		p, ok := s.Properties.Get(name)
+		if !ok {
+			p = &Schema{}
+			s.Properties.Set(name, p)
+		}
+		mergeSchema(p, a.Properties[name])
+	}
+	switch v := a.AdditionalProperties.GetValue().(type) {
+	case *openapiv3.AdditionalProperties_Schema:
+		if s.AdditionalProperties == nil || s.AdditionalProperties.Schema == nil {
+			s.AdditionalProperties = &SchemaOrBool{Schema: &Schema{}}
+		}
+		mergeSchema(s.AdditionalProperties.Schema, v.Schema)
+	case *openapiv3.AdditionalProperties_Allowed:
+		s.AdditionalProperties = &SchemaOrBool{Bool: v.Allowed}
+	}
+	if len(a.AllOf) > 0 {
+		s.AllOf = schemas(a.AllOf)
+	}
+	if len(a.AnyOf) > 0 {
+		s.AnyOf = schemas(a.AnyOf)
+	}
+	if len(a.OneOf) > 0 {
+		s.OneOf = schemas(a.OneOf)
+	}
+	if a.Not != nil {
+		s.Not = &Schema{}
+		mergeSchema(s.Not, a.Not)
+	}
+}
+
+// schemas returns new schemas for the options.
+func schemas(a []*openapiv3.Schema) []*Schema {
+	var schemas []*Schema
+	for _, as := range a {
+		s := &Schema{}
+		mergeSchema(s, as)
+		schemas = append(schemas, s)
+	}
+	return schemas
+}
+
+func servers(a []*openapiv3.Server) []Server {
+	var servers []Server
+	for _, as := range a {
+		s := Server{URL: as.Url, Description: as.Description}
+		for name, v := range as.Variables {
+			if s.Variables == nil {
+				s.Variables = map[string]ServerVariable{}
+			}
+			s.Variables[name] = ServerVariable{Enum: v.Enum, Default: v.Default, Description: v.Description}
+		}
+		servers = append(servers, s)
+	}
+	return servers
+}
+
+// security returns the requirements of the option, or nil if it is not set. An option without requirements returns an empty list, which removes the requirements that would apply otherwise.
+func security(a *openapiv3.Security) *[]SecurityRequirement {
+	if a == nil {
+		return nil
+	}
+	requirements := []SecurityRequirement{}
+	for _, ar := range a.Requirements {
+		r := SecurityRequirement{}
+		for name, scopes := range ar.Schemes {
+			r[name] = append([]string{}, scopes.GetScopes()...)
+		}
+		requirements = append(requirements, r)
+	}
+	return &requirements
+}
+
+func securityScheme(a *openapiv3.SecurityScheme) SecurityScheme {
+	s := SecurityScheme{
+		Type:             a.Type,
+		Description:      a.Description,
+		Name:             a.Name,
+		In:               a.In,
+		Scheme:           a.Scheme,
+		BearerFormat:     a.BearerFormat,
+		OpenIDConnectURL: a.OpenIdConnectUrl,
+	}
+	if f := a.Flows; f != nil {
+		s.Flows = &OAuthFlows{
+			Implicit:          oauthFlow(f.Implicit),
+			Password:          oauthFlow(f.Password),
+			ClientCredentials: oauthFlow(f.ClientCredentials),
+			AuthorizationCode: oauthFlow(f.AuthorizationCode),
+		}
+	}
+	return s
+}
+
+func oauthFlow(a *openapiv3.OAuthFlow) *OAuthFlow {
+	if a == nil {
+		return nil
+	}
+	scopes := map[string]string{}
+	for name, description := range a.Scopes {
+		scopes[name] = description
+	}
+	return &OAuthFlow{AuthorizationURL: a.AuthorizationUrl, TokenURL: a.TokenUrl, RefreshURL: a.RefreshUrl, Scopes: scopes}
+}
+
+func externalDocs(a *openapiv3.ExternalDocumentation) *ExternalDocumentation {
+	return &ExternalDocumentation{Description: a.Description, URL: a.Url}
+}
+
+func values(a []*structpb.Value) []interface{} {
+	values := make([]interface{}, len(a))
+	for i, v := range a {
+		values[i] = v.AsInterface()
+	}
+	return values
+}
+
+func setString(s *string, value string) {
+	if value != "" {
+		*s = value
+	}
+}
+
+func setFloat(f **float64, value *float64) {
+	if value != nil {
+		v := *value
+		*f = &v
+	}
+}
+
+func setUint(u **uint64, value *uint64) {
+	if value != nil {
+		v := *value
+		*u = &v
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
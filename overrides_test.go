@@ -0,0 +1,251 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"gopkg.in/yaml.v3"
+)
+
+const annotatedProto = `
+	syntax = "proto3";
+	package example.v1;
+	import "google/api/annotations.proto";
+	import "openapi/v3/annotations.proto";
+	option (protoc_gen_openapi.v3.document) = {
+		info: {
+			title: "Library API"
+			contact: {name: "Library team", email: "library@example.com"}
+			license: {name: "Apache 2.0", identifier: "Apache-2.0"}
+		}
+		servers: {url: "https://{region}.example.com", variables: {key: "region", value: {default: "eu", enum: ["eu", "us"]}}}
+		tags: {name: "Library", external_docs: {url: "https://example.com/library"}}
+		tags: {name: "Admin", description: "Administration."}
+	};
+	// Manages books.
+	service Library {
+		option (protoc_gen_openapi.v3.tag) = {name: "Books"};
+		rpc GetBook(GetBookRequest) returns (Book) {
+			option (google.api.http) = {get: "/v1/{name=books/*}", additional_bindings {get: "/v2/{name}"}};
+			option (protoc_gen_openapi.v3.operation) = {
+				operation_id: "getBook"
+				summary: "Get a book"
+				tags: ["Books", "Reading"]
+				parameters: {name: "X-Request-Id", in: "header", schema: {type: "string", format: "uuid"}}
+				parameters: {name: "view", in: "query", example: {string_value: "FULL"}}
+				responses: {key: "404", value: {}}
+				responses: {key: "200", value: {description: "The book.", content: {key: "application/json", value: {example: {struct_value: {fields: {key: "name", value: {string_value: "books/1"}}}}}}}}
+			};
+		}
+	}
+	message Book {
+		option (protoc_gen_openapi.v3.schema) = {title: "Book", required: ["name"], extensions: {key: "x-resource", value: {bool_value: true}}};
+		string name = 1 [(protoc_gen_openapi.v3.property) = {pattern: "^books/[^/]+$", examples: [{string_value: "books/1"}]}];
+		int32 pages = 2 [(protoc_gen_openapi.v3.property) = {minimum: 1, description: "Number of pages."}];
+		Book sequel = 3 [(protoc_gen_openapi.v3.property) = {ref: "#/components/schemas/Sequel", description: "The next book."}];
+	}
+	message GetBookRequest {
+		string name = 1;
+		string view = 2 [(protoc_gen_openapi.v3.property) = {description: "The view of the book.", enum: [{string_value: "BASIC"}, {string_value: "FULL"}]}];
+	}
+`
+
+func TestOverrides(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": annotatedProto}, "")
+	assertPath(t, doc, "Library API", "info", "title")
+	assertPath(t, doc, "v1", "info", "version")
+	assertPath(t, doc, "library@example.com", "info", "contact", "email")
+	assertPath(t, doc, "Apache-2.0", "info", "license", "identifier")
+	assertPath(t, doc, "eu", "servers", 0, "variables", "region", "default")
+
+	// The plugin options take precedence over the annotations.
+	options := generateYAML(t, map[string]string{"library.proto": annotatedProto}, "title=Option,version=v2")
+	assertPath(t, options, "Option", "info", "title")
+	assertPath(t, options, "v2", "info", "version")
+	assertPath(t, options, "library@example.com", "info", "contact", "email")
+
+	tags := mustLookup(t, doc, "tags")
+	assertPath(t, tags, "Books", 0, "name")
+	assertPath(t, tags, "Manages books.", 0, "description")
+	assertPath(t, tags, "Library", 1, "name")
+	assertPath(t, tags, "https://example.com/library", 1, "externalDocs", "url")
+	assertPath(t, tags, "Admin", 2, "name")
+
+	get := mustLookup(t, doc, "paths", "/v1/books/{book}", "get")
+	assertPath(t, get, "getBook", "operationId")
+	assertPath(t, doc, "getBook_1", "paths", "/v2/{name}", "get", "operationId")
+	assertPath(t, get, "Get a book", "summary")
+	assertPath(t, get, []interface{}{"Books", "Reading"}, "tags")
+	assertPath(t, get, "view", "parameters", 1, "name")
+	assertPath(t, get, "The view of the book.", "parameters", 1, "description")
+	assertPath(t, get, []interface{}{"BASIC", "FULL"}, "parameters", 1, "schema", "enum")
+	assertNoPath(t, get, "parameters", 1, "schema", "description")
+	assertPath(t, get, "FULL", "parameters", 1, "example")
+	assertPath(t, get, "header", "parameters", 2, "in")
+	assertPath(t, get, "uuid", "parameters", 2, "schema", "format")
+	assertPath(t, get, "Not Found", "responses", "404", "description")
+	assertPath(t, get, "The book.", "responses", "200", "description")
+	assertPath(t, get, "#/components/schemas/example.v1.Book", "responses", "200", "content", "application/json", "schema", "$ref")
+	assertPath(t, get, "books/1", "responses", "200", "content", "application/json", "example", "name")
+
+	book := mustLookup(t, doc, "components", "schemas", "example.v1.Book")
+	assertPath(t, book, "Book", "title")
+	assertPath(t, book, []interface{}{"name"}, "required")
+	assertPath(t, book, true, "x-resource")
+	assertPath(t, book, "string", "properties", "name", "type")
+	assertPath(t, book, "^books/[^/]+$", "properties", "name", "pattern")
+	assertPath(t, book, []interface{}{"books/1"}, "properties", "name", "examples")
+	assertPath(t, book, 1, "properties", "pages", "minimum")
+	assertPath(t, book, "int32", "properties", "pages", "format")
+	assertPath(t, book, "Number of pages.", "properties", "pages", "description")
+	assertPath(t, book, "#/components/schemas/Sequel", "properties", "sequel", "$ref")
+	assertPath(t, book, "The next book.", "properties", "sequel", "description")
+}
+
+const composedProto = `
+	syntax = "proto3";
+	package example.v1;
+	import "google/api/annotations.proto";
+	import "openapi/v3/annotations.proto";
+	service Library {
+		rpc CreateBook(Book) returns (Book) {
+			option (google.api.http) = {post: "/v1/books", body: "*"};
+			option (protoc_gen_openapi.v3.operation) = {
+				request_body: {description: "The book to create.", content: {key: "application/json", value: {example: {struct_value: {fields: {key: "name", value: {string_value: "books/1"}}}}}}}
+				responses: {key: "201", value: {
+					description: "The created book."
+					headers: {key: "Location", value: {description: "The URL of the book.", required: true, schema: {type: "string", format: "uri"}}}
+					links: {key: "GetBook", value: {operation_id: "example_v1_Library_GetBook", parameters: {key: "book", value: {string_value: "$response.body#/name"}}}}
+				}}
+			};
+		}
+		rpc GetBook(Book) returns (Book) {
+			option (google.api.http) = {get: "/v1/{name=books/*}"};
+		}
+	}
+	message Book {
+		string name = 1;
+		repeated string labels = 2 [(protoc_gen_openapi.v3.property) = {items: {min_length: 1}}];
+		map<string, string> metadata = 3 [(protoc_gen_openapi.v3.property) = {additional_properties: {schema: {max_length: 64}}}];
+		string isbn = 4 [(protoc_gen_openapi.v3.property) = {one_of: [{pattern: "^[0-9]{9}[0-9X]$"}, {pattern: "^97[89][0-9]{10}$"}]}];
+		Cover cover = 5;
+	}
+	message Cover {
+		option (protoc_gen_openapi.v3.schema) = {
+			properties: {key: "url", value: {format: "uri"}}
+			properties: {key: "alt", value: {type: "string"}}
+			additional_properties: {allowed: false}
+			not: {required: ["alt", "url"]}
+		};
+		string url = 1;
+	}
+`
+
+func TestOverridesComposition(t *testing.T) {
+	doc := generateYAML(t, map[string]string{"library.proto": composedProto}, "")
+	create := mustLookup(t, doc, "paths", "/v1/books", "post")
+	assertPath(t, create, "The book to create.", "requestBody", "description")
+	assertPath(t, create, "#/components/schemas/example.v1.Book", "requestBody", "content", "application/json", "schema", "$ref")
+	assertPath(t, create, "books/1", "requestBody", "content", "application/json", "example", "name")
+	created := mustLookup(t, create, "responses", "201")
+	assertPath(t, created, "uri", "headers", "Location", "schema", "format")
+	assertPath(t, created, true, "headers", "Location", "required")
+	assertPath(t, created, "example_v1_Library_GetBook", "links", "GetBook", "operationId")
+	assertPath(t, created, "$response.body#/name", "links", "GetBook", "parameters", "book")
+
+	book := mustLookup(t, doc, "components", "schemas", "example.v1.Book", "properties")
+	assertPath(t, book, "string", "labels", "items", "type")
+	assertPath(t, book, 1, "labels", "items", "minLength")
+	assertPath(t, book, "string", "metadata", "additionalProperties", "type")
+	assertPath(t, book, 64, "metadata", "additionalProperties", "maxLength")
+	assertPath(t, book, "string", "isbn", "type")
+	assertPath(t, book, "^[0-9]{9}[0-9X]$", "isbn", "oneOf", 0, "pattern")
+	assertPath(t, book, "^97[89][0-9]{10}$", "isbn", "oneOf", 1, "pattern")
+
+	cover := mustLookup(t, doc, "components", "schemas", "example.v1.Cover")
+	assertPath(t, cover, "string", "properties", "url", "type")
+	assertPath(t, cover, "uri", "properties", "url", "format")
+	assertPath(t, cover, "string", "properties", "alt", "type")
+	assertPath(t, cover, false, "additionalProperties")
+	assertPath(t, cover, []interface{}{"alt", "url"}, "not", "required")
+}
+
+const securedProto = `
+	syntax = "proto3";
+	package example.v1;
+	import "google/api/annotations.proto";
+	import "openapi/v3/annotations.proto";
+	option (protoc_gen_openapi.v3.document) = {
+		security_schemes: {key: "apiKey", value: {type: "apiKey", name: "X-API-Key", in: "header"}}
+		security_schemes: {key: "oauth", value: {
+			type: "oauth2"
+			flows: {authorization_code: {
+				authorization_url: "https://auth.example.com/authorize"
+				token_url: "https://auth.example.com/token"
+				scopes: {key: "books.read", value: "Read books."}
+			}}
+		}}
+		security: {requirements: {schemes: {key: "apiKey", value: {}}}}
+	};
+	service Library {
+		option (protoc_gen_openapi.v3.security) = {requirements: {schemes: {key: "oauth", value: {scopes: ["books.read"]}}}};
+		rpc GetBook(Book) returns (Book) {
+			option (google.api.http) = {get: "/v1/books/{name}"};
+		}
+		rpc Health(Book) returns (Book) {
+			option (google.api.http) = {get: "/healthz"};
+			option (protoc_gen_openapi.v3.operation) = {security: {}};
+		}
+		rpc ExportBook(Book) returns (Book) {
+			option (google.api.http) = {get: "/v1/books/{name}:export"};
+			option (protoc_gen_openapi.v3.operation) = {security: {requirements: {schemes: {key: "apiKey", value: {}}}, requirements: {schemes: {key: "mtls", value: {}}}}};
+		}
+	}
+	service Admin {
+		rpc Reset(Book) returns (Book) {
+			option (google.api.http) = {post: "/v1/reset"};
+		}
+	}
+	message Book {
+		string name = 1;
+	}
+`
+
+func TestSecurity(t *testing.T) {
+	out := runPlugin(t, newRequest(t, map[string]string{"library.proto": securedProto}, "output_format=both"))
+	for _, name := range []string{"openapi.yaml", "openapi.json"} {
+		var doc interface{}
+		if err := yaml.Unmarshal([]byte(out[name]), &doc); err != nil {
+			t.Fatal(err)
+		}
+		schemes := mustLookup(t, doc, "components", "securitySchemes")
+		assertPath(t, schemes, "header", "apiKey", "in")
+		assertPath(t, schemes, "Read books.", "oauth", "flows", "authorizationCode", "scopes", "books.read")
+		assertPath(t, doc, []interface{}{}, "security", 0, "apiKey")
+
+		paths := mustLookup(t, doc, "paths")
+		assertPath(t, paths, []interface{}{"books.read"}, "/v1/books/{name}", "get", "security", 0, "oauth")
+		assertPath(t, paths, []interface{}{}, "/healthz", "get", "security")
+		assertPath(t, paths, []interface{}{}, "/v1/books/{name}:export", "get", "security", 1, "mtls")
+		assertNoPath(t, paths, "/v1/reset", "post", "security")
+	}
+	if !strings.Contains(out["openapi.yaml"], "security: []") {
+		t.Errorf("public operation does not remove the security requirements:\n%s", out["openapi.yaml"])
+	}
+
+	gen, err := protogen.Options{}.New(newRequest(t, map[string]string{"library.proto": securedProto}, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, _ := newNames(gen, "fqn")
+	g := newGenerator(gen, newConfig(), &documentFile{name: "openapi", files: gen.Files}, map[string]string{}, names, "yaml")
+	if _, err := g.document(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{`example_v1_Library_ExportBook: security scheme "mtls" is not declared`}
+	if !reflect.DeepEqual(g.warnings, want) {
+		t.Errorf("got warnings %q, want %q", g.warnings, want)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import "google.golang.org/protobuf/reflect/protoreflect"
+
+// wellKnownSchema returns the schema of the canonical proto3 JSON form of a well-known type from google/protobuf, or nil for other messages.
+func (g *generator) wellKnownSchema(m protoreflect.MessageDescriptor) *Schema {
+	switch m.FullName() {
+	case "google.protobuf.Timestamp":
+		return &Schema{
+			Type:        SchemaType{"string"},
+			Format:      "date-time",
+			Description: "An RFC 3339 date-time in UTC, with 0, 3, 6 or 9 fractional digits, such as 2017-01-15T01:30:15.01Z.",
+		}
+	case "google.protobuf.Duration":
+		return &Schema{
+			Type:        SchemaType{"string"},
+			Pattern:     `^-?[0-9]+(\.[0-9]{1,9})?s$`,
+			Description: "A signed number of seconds with up to 9 fractional digits, followed by the suffix s, such as 1.5s.",
+		}
+	case "google.protobuf.FieldMask":
+		return &Schema{
+			Type:        SchemaType{"string"},
+			Format:      "field-mask",
+			Description: "A comma-separated list of field paths in lowerCamelCase, such as user.displayName,photo.",
+		}
+	case "google.protobuf.Struct":
+		return &Schema{Type: SchemaType{"object"}, AdditionalProperties: &SchemaOrBool{Bool: true}}
+	case "google.protobuf.Value":
+		return &Schema{Type: SchemaType{"null", "boolean", "number", "string", "array", "object"}}
+	case "google.protobuf.ListValue":
+		return &Schema{Type: SchemaType{"array"}, Items: &Schema{}}
+	case "google.protobuf.Any":
+		s := &Schema{
+			Type:                 SchemaType{"object"},
+			Required:             []string{"@type"},
+			AdditionalProperties: &SchemaOrBool{Bool: true},
+			Description:          "An arbitrary message, with the fields of the message or, for well-known types, its JSON form in a value field, next to a URL identifying its type.",
+		}
+		s.Properties.Set("@type", &Schema{Type: SchemaType{"string"}, Description: "A URL identifying the type of the message, such as type.googleapis.com/google.protobuf.Duration."})
+		return s
+	case "google.protobuf.Empty":
+		return &Schema{Type: SchemaType{"object"}}
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value", "google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		// Wrappers are written as the JSON form of their value field, or null when absent.
+		return nullable(g.scalarSchema(m.Fields().Get(0).Kind()))
+	}
+	return nil
+}
+
+// nullable returns the schema extended to also accept null.
+func nullable(s *Schema) *Schema {
+	if len(s.OneOf) > 0 {
+		s.OneOf = append(s.OneOf, &Schema{Type: SchemaType{"null"}})
+		return s
+	}
+	s.Type = append(s.Type, "null")
+	return s
+}